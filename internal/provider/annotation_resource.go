@@ -29,6 +29,7 @@ type annotationResourceModel struct {
 	Key            types.String `tfsdk:"key"`
 	Value          types.String `tfsdk:"value"`
 	OrganizationID types.String `tfsdk:"organization_id"`
+	OrgID          types.String `tfsdk:"org_id"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
 }
@@ -60,6 +61,7 @@ func (r *annotationResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 				Description: "Annotation value.",
 			},
+			"org_id": orgIDResourceAttribute(),
 			"organization_id": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -106,6 +108,11 @@ func (r *annotationResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := createAnnotationPayload{
 		Key:   plan.Key.ValueString(),
 		Value: plan.Value.ValueString(),
@@ -141,6 +148,11 @@ func (r *annotationResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Annotation ID is required in state to read annotation.")
@@ -187,6 +199,11 @@ func (r *annotationResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Annotation ID is required in state to update annotation.")
@@ -230,6 +247,11 @@ func (r *annotationResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		return
@@ -247,8 +269,8 @@ func (r *annotationResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *annotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_annotation.example <annotation_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_annotation.example <annotation_id> or <org_id>/<annotation_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
 }
 
 func mapAnnotationToModel(m *annotationResourceModel, a *annotation) {
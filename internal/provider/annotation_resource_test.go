@@ -0,0 +1,29 @@
+package provider
+
+import "testing"
+
+func TestAnnotationResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	ann := h.resource("autoglue_annotation")
+	ann.Apply(map[string]any{"key": "owner", "value": "platform"})
+	id := ann.Attr("id")
+
+	ann.Apply(map[string]any{"key": "owner", "value": "sre"})
+	if ann.Attr("id") != id || api.annotations[id].Value != "sre" {
+		t.Errorf("annotation not updated in place: %+v", api.annotations[id])
+	}
+
+	ann.ImportVerify(id)
+
+	list := h.ReadDataSource("autoglue_annotations", nil)
+	if got := len(objectElems(t, list["annotations"])); got != 1 {
+		t.Errorf("annotations data source returned %d, want 1", got)
+	}
+
+	ann.Destroy()
+	if len(api.annotations) != 0 {
+		t.Errorf("expected annotation to be deleted")
+	}
+}
@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,7 +21,13 @@ type annotationsDataSource struct {
 }
 
 type annotationsDataSourceModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+	Q     types.String `tfsdk:"q"`
+
 	Annotations []annotationDataModel `tfsdk:"annotations"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type annotationDataModel struct {
@@ -43,11 +49,23 @@ func (d *annotationsDataSource) Metadata(_ context.Context, req datasource.Metad
 
 func (d *annotationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Lists all annotations in the organization.",
+		Description: "Lists annotations in the organization, optionally filtered by key, value, or query string.",
 		Attributes: map[string]dsschema.Attribute{
+			"key": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Exact annotation key to filter by.",
+			},
+			"value": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Exact annotation value to filter by.",
+			},
+			"q": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Case-insensitive substring match on key.",
+			},
 			"annotations": dsschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "All annotations visible to the organization.",
+				Description: "Matching annotations.",
 				NestedObject: dsschema.NestedAttributeObject{
 					Attributes: map[string]dsschema.Attribute{
 						"id": dsschema.StringAttribute{
@@ -77,6 +95,7 @@ func (d *annotationsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 					},
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -105,17 +124,37 @@ func (d *annotationsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	var state annotationsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := url.Values{}
+	setQueryFilter(q, "key", state.Key)
+	setQueryFilter(q, "value", state.Value)
+	setQueryFilter(q, "q", state.Q)
 
-	tflog.Info(ctx, "Listing Autoglue annotations")
+	tflog.Info(ctx, "Listing Autoglue annotations", map[string]any{
+		"query": q.Encode(),
+	})
 
-	var apiResp []annotation
-	if err := d.client.doJSON(ctx, http.MethodGet, "/annotations", "", nil, &apiResp); err != nil {
+	apiResp, err := listAll[annotation](ctx, d.client, "/annotations", q)
+	if err != nil {
 		resp.Diagnostics.AddError("Error listing annotations", err.Error())
 		return
 	}
 
 	state.Annotations = make([]annotationDataModel, 0, len(apiResp))
 	for _, a := range apiResp {
+		if !matchesExact(state.Key, a.Key) || !matchesExact(state.Value, a.Value) {
+			continue
+		}
 		state.Annotations = append(state.Annotations, annotationDataModel{
 			ID:             types.StringValue(a.ID),
 			Key:            types.StringValue(a.Key),
@@ -126,6 +165,6 @@ func (d *annotationsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		})
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -32,8 +32,18 @@ type autoglueClient struct {
 	orgKey        string
 	orgSecret     string
 	bearerToken   string
+	tokenSource   *bearerTokenSource
 	sendOrgHeader bool
+	memberships   *orgMemberships
+	headers       map[string]string
 	httpClient    *http.Client
+	retry         retryPolicy
+	// serverVersion is the API version reported by GET /version during
+	// preflight, or empty if unknown.
+	serverVersion string
+	correlationID string
+	limiter       chan struct{}
+	reads         *readCache
 }
 
 type clientConfig struct {
@@ -43,6 +53,22 @@ type clientConfig struct {
 	OrgKey      string
 	OrgSecret   string
 	BearerToken string
+	// OIDC, when enabled, exchanges a workload identity token for
+	// short-lived bearer tokens instead of using BearerToken.
+	OIDC oidcConfig
+
+	RetryMaxAttempts int
+	RetryMaxWait     time.Duration
+
+	// MaxConcurrentRequests caps requests in flight; zero means the default.
+	MaxConcurrentRequests int
+	// ReadCacheTTL is how long GET responses are reused; zero disables the
+	// cache, though identical concurrent GETs are still coalesced.
+	ReadCacheTTL time.Duration
+
+	Transport transportConfig
+	// Headers are sent with every request. Auth and org headers take precedence.
+	Headers map[string]string
 }
 
 func (e *apiError) hasRateLimitInfo() bool {
@@ -111,11 +137,19 @@ func newAutoglueClient(cfg clientConfig) (*autoglueClient, error) {
 	}
 	hasOrgCreds := hasOrgKey
 
+	if err := cfg.OIDC.validate(); err != nil {
+		return nil, err
+	}
+	hasOIDC := cfg.OIDC.enabled()
+	if hasOIDC && bearerToken != "" {
+		return nil, fmt.Errorf("bearer_token cannot be combined with oidc_token, oidc_token_file or oidc_github_actions")
+	}
+
 	// Must provide one auth method
 	hasAPIKey := apiKey != ""
-	hasBearer := bearerToken != ""
+	hasBearer := bearerToken != "" || hasOIDC
 	if !hasAPIKey && !hasOrgCreds && !hasBearer {
-		return nil, fmt.Errorf("one of api_key, (org_key + org_secret), or bearer_token must be configured")
+		return nil, fmt.Errorf("one of api_key, (org_key + org_secret), bearer_token, or an OIDC token must be configured")
 	}
 
 	// org_id required only for api_key or bearer_token
@@ -124,6 +158,26 @@ func newAutoglueClient(cfg clientConfig) (*autoglueClient, error) {
 		return nil, fmt.Errorf("org_id must be configured when using api_key or bearer_token")
 	}
 
+	httpClient, err := newHTTPClient(cfg.Transport)
+	if err != nil {
+		return nil, err
+	}
+
+	maxConcurrent := cfg.MaxConcurrentRequests
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultMaxConcurrentRequests
+	}
+
+	var tokenSource *bearerTokenSource
+	if hasOIDC {
+		tokenSource = &bearerTokenSource{
+			cfg:        cfg.OIDC,
+			headers:    cfg.Headers,
+			httpClient: httpClient,
+			now:        time.Now,
+		}
+	}
+
 	return &autoglueClient{
 		baseURL:       baseURL,
 		orgID:         orgID,
@@ -131,14 +185,25 @@ func newAutoglueClient(cfg clientConfig) (*autoglueClient, error) {
 		orgKey:        orgKey,
 		orgSecret:     orgSecret,
 		bearerToken:   bearerToken,
+		tokenSource:   tokenSource,
 		sendOrgHeader: needsOrgID,
-		httpClient: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+		memberships:   &orgMemberships{},
+		correlationID: newCorrelationID(),
+		limiter:       make(chan struct{}, maxConcurrent),
+		reads:         newReadCache(cfg.ReadCacheTTL),
+		headers:       cfg.Headers,
+		httpClient:    httpClient,
+		retry: retryPolicy{
+			MaxAttempts: cfg.RetryMaxAttempts,
+			MaxWait:     cfg.RetryMaxWait,
+		}.withDefaults(),
 	}, nil
 }
 
 // doJSON performs an HTTP request with a JSON body and decodes a JSON response into out (if non-nil).
+// Throttled (429) and transiently failing (502/503/504, transport errors) requests are
+// retried according to the client's retryPolicy. With OIDC auth, a 401 triggers one
+// retry with a freshly exchanged bearer token.
 func (c *autoglueClient) doJSON(
 	ctx context.Context,
 	method string,
@@ -147,6 +212,154 @@ func (c *autoglueClient) doJSON(
 	body any,
 	out any,
 ) error {
+	_, err := c.doJSONWithHeaders(ctx, method, path, query, body, out)
+	return err
+}
+
+// doJSONWithHeaders is doJSON, but also returns the headers of the successful
+// response so callers can follow pagination links. GETs go through the
+// client's readCache; any other request invalidates it.
+func (c *autoglueClient) doJSONWithHeaders(
+	ctx context.Context,
+	method string,
+	path string,
+	query string,
+	body any,
+	out any,
+) (http.Header, error) {
+	if method != http.MethodGet {
+		defer c.reads.invalidate()
+		return c.do(ctx, method, path, query, body, out)
+	}
+
+	orgID, _ := orgScopeFromContext(ctx)
+	key := orgID + " " + path + "?" + strings.TrimPrefix(query, "?")
+	header, raw, err := c.reads.get(ctx, key, func() (http.Header, []byte, error) {
+		var raw json.RawMessage
+		header, err := c.do(ctx, method, path, query, nil, &raw)
+		return header, raw, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if out != nil && len(raw) > 0 {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+	}
+	return header, nil
+}
+
+// getUncached GETs path like doJSON but bypasses the read cache, so secrets
+// such as private keys are never held in memory beyond the request.
+func (c *autoglueClient) getUncached(ctx context.Context, path string, query string, out any) error {
+	_, err := c.do(ctx, http.MethodGet, path, query, nil, out)
+	return err
+}
+
+// do is doJSONWithHeaders without the read cache: it performs the request,
+// retrying per the client's retryPolicy.
+func (c *autoglueClient) do(
+	ctx context.Context,
+	method string,
+	path string,
+	query string,
+	body any,
+	out any,
+) (http.Header, error) {
+	var payload []byte
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshal request: %w", err)
+		}
+		payload = b
+	}
+
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		token, err := c.currentBearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.acquire(ctx); err != nil {
+			return nil, err
+		}
+		header, err := c.doOnce(ctx, method, path, query, payload, token, attempt+1, out)
+		c.release()
+		if err == nil {
+			return header, nil
+		}
+
+		// The cached token may have been revoked or expired early; exchange a
+		// new one and retry once without counting it as a retry attempt.
+		var ae *apiError
+		if c.tokenSource != nil && !refreshed && errors.As(err, &ae) && ae.StatusCode == http.StatusUnauthorized {
+			tflog.Debug(ctx, "Autoglue bearer token rejected; exchanging a new one", map[string]any{
+				"method": method,
+				"path":   path,
+			})
+			c.tokenSource.Invalidate(token)
+			refreshed = true
+			attempt--
+			continue
+		}
+
+		retry, wait, serverWait := c.retry.retryDecision(method, attempt, err, time.Now())
+		if !retry {
+			return nil, err
+		}
+		if wait > c.retry.MaxWait {
+			if serverWait {
+				tflog.Warn(ctx, "Autoglue requested retry wait exceeds retry_max_wait; giving up", map[string]any{
+					"method":         method,
+					"path":           path,
+					"wait":           wait.String(),
+					"retry_max_wait": c.retry.MaxWait.String(),
+				})
+				return nil, err
+			}
+			wait = c.retry.MaxWait
+		}
+
+		tflog.Debug(ctx, "Retrying Autoglue request", map[string]any{
+			"method":       method,
+			"path":         path,
+			"attempt":      attempt + 1,
+			"max_attempts": c.retry.MaxAttempts,
+			"wait":         wait.String(),
+			"error":        err.Error(),
+		})
+
+		if serr := sleepWithContext(ctx, wait); serr != nil {
+			return nil, fmt.Errorf("%w (not retried: %s)", err, serr)
+		}
+	}
+}
+
+// currentBearerToken returns the bearer token to authenticate with, if any:
+// the static bearer_token, or a cached token exchanged via OIDC.
+func (c *autoglueClient) currentBearerToken(ctx context.Context) (string, error) {
+	if c.tokenSource != nil {
+		return c.tokenSource.Token(ctx)
+	}
+	return c.bearerToken, nil
+}
+
+// doOnce performs a single attempt of a doJSON request, logging it at DEBUG
+// with secrets masked.
+func (c *autoglueClient) doOnce(
+	ctx context.Context,
+	method string,
+	path string,
+	query string,
+	payload []byte,
+	bearerToken string,
+	attempt int,
+	out any,
+) (http.Header, error) {
+	ctx = c.logContext(ctx, bearerToken)
+
 	url := c.baseURL + path
 	if query != "" {
 		if !strings.HasPrefix(query, "?") {
@@ -156,27 +369,33 @@ func (c *autoglueClient) doJSON(
 	}
 
 	var bodyReader io.Reader
-	if body != nil {
-		b, err := json.Marshal(body)
-		if err != nil {
-			return fmt.Errorf("marshal request: %w", err)
-		}
-		bodyReader = bytes.NewReader(b)
+	if payload != nil {
+		bodyReader = bytes.NewReader(payload)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
-		return fmt.Errorf("build request: %w", err)
+		return nil, fmt.Errorf("build request: %w", err)
 	}
 
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+	// Set after the static headers so a configured X-Correlation-ID can't
+	// break correlation with the provider's own logs.
+	req.Header.Set(correlationIDHeader, c.correlationID)
 	req.Header.Set("Accept", "application/json")
-	if body != nil {
+	if payload != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
-
-	// Org scoping: only needed for api_key or bearer_token auth.
+	// Org scoping: only needed for api_key or bearer_token auth. A resource's
+	// org_id override, carried in ctx, takes precedence over the provider's.
 	if c.sendOrgHeader {
-		req.Header.Set("X-Org-ID", c.orgID)
+		orgID, ok := orgScopeFromContext(ctx)
+		if !ok {
+			orgID = c.orgID
+		}
+		req.Header.Set("X-Org-ID", orgID)
 	}
 
 	// Auth
@@ -189,20 +408,35 @@ func (c *autoglueClient) doJSON(
 	if c.orgSecret != "" {
 		req.Header.Set("X-ORG-SECRET", c.orgSecret)
 	}
-	if c.bearerToken != "" {
+	if bearerToken != "" {
 		// Autoglue uses Bearer tokens in Authorization
-		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
 	}
 
+	trace := requestTrace{
+		method:        method,
+		path:          path,
+		query:         query,
+		attempt:       attempt,
+		correlationID: c.correlationID,
+		header:        req.Header,
+		staticHeaders: c.headers,
+		payload:       payload,
+		start:         time.Now(),
+	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("perform request: %w", err)
+		trace.err = err
+		logRequest(ctx, trace)
+		return nil, fmt.Errorf("perform request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	trace.resp, trace.respBody, trace.err = resp, respBody, err
+	logRequest(ctx, trace)
 	if err != nil {
-		return fmt.Errorf("read response: %w", err)
+		return nil, fmt.Errorf("read response: %w", err)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -234,16 +468,16 @@ func (c *autoglueClient) doJSON(
 			})
 		}
 
-		return apiErr
+		return nil, apiErr
 	}
 
 	if out == nil || len(respBody) == 0 {
-		return nil
+		return resp.Header, nil
 	}
 
 	if err := json.Unmarshal(respBody, out); err != nil {
-		return fmt.Errorf("decode response: %w", err)
+		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
-	return nil
+	return resp.Header, nil
 }
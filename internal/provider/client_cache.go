@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxConcurrentRequests = 8
+	defaultReadCacheTTL          = 5 * time.Second
+
+	// readCachePruneSize is the entry count above which expired entries are
+	// swept on insert.
+	readCachePruneSize = 256
+)
+
+// readCache coalesces identical concurrent GETs into one request and keeps
+// successful responses for a short TTL, so resources that read the same
+// object during one run (e.g. a cluster and its attachments) share a single
+// API call. Any write invalidates the whole cache.
+type readCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu sync.Mutex
+	// gen is bumped by every write. Reads started in an older generation
+	// are neither cached nor joined by newer ones.
+	gen      uint64
+	entries  map[string]cachedRead
+	inflight map[string]*inflightRead
+}
+
+type cachedRead struct {
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+type inflightRead struct {
+	done   chan struct{}
+	header http.Header
+	body   []byte
+	err    error
+}
+
+func newReadCache(ttl time.Duration) *readCache {
+	return &readCache{
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  map[string]cachedRead{},
+		inflight: map[string]*inflightRead{},
+	}
+}
+
+type freshReadKey struct{}
+
+// withFreshReads returns ctx whose GETs skip cached responses, for polling
+// loops that wait for an object to change. Concurrent identical GETs are
+// still coalesced.
+func withFreshReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freshReadKey{}, true)
+}
+
+func freshReads(ctx context.Context) bool {
+	fresh, _ := ctx.Value(freshReadKey{}).(bool)
+	return fresh
+}
+
+// invalidate drops every cached response. Called after any write, since it
+// may change what other paths return.
+func (rc *readCache) invalidate() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.gen++
+	clear(rc.entries)
+}
+
+// get returns the response for key from the cache, from an identical
+// request already in flight, or by calling fetch.
+func (rc *readCache) get(ctx context.Context, key string, fetch func() (http.Header, []byte, error)) (http.Header, []byte, error) {
+	rc.mu.Lock()
+	if e, ok := rc.entries[key]; ok && !freshReads(ctx) && rc.now().Before(e.expires) {
+		rc.mu.Unlock()
+		tflog.Trace(ctx, "Autoglue read cache hit", map[string]any{"key": key})
+		return e.header, e.body, nil
+	}
+
+	gen := rc.gen
+	flightKey := fmt.Sprintf("%d %s", gen, key)
+	if f, ok := rc.inflight[flightKey]; ok {
+		rc.mu.Unlock()
+		tflog.Trace(ctx, "Joining in-flight Autoglue request", map[string]any{"key": key})
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		// The request we joined was cancelled by its own caller; ours may
+		// still have time, so try on our own.
+		if isContextError(f.err) && ctx.Err() == nil {
+			return fetch()
+		}
+		return f.header, f.body, f.err
+	}
+
+	f := &inflightRead{done: make(chan struct{})}
+	rc.inflight[flightKey] = f
+	rc.mu.Unlock()
+
+	f.header, f.body, f.err = fetch()
+
+	rc.mu.Lock()
+	delete(rc.inflight, flightKey)
+	if f.err == nil && rc.ttl > 0 && rc.gen == gen {
+		if len(rc.entries) >= readCachePruneSize {
+			rc.pruneLocked()
+		}
+		rc.entries[key] = cachedRead{header: f.header, body: f.body, expires: rc.now().Add(rc.ttl)}
+	}
+	rc.mu.Unlock()
+	close(f.done)
+
+	return f.header, f.body, f.err
+}
+
+func (rc *readCache) pruneLocked() {
+	now := rc.now()
+	for k, e := range rc.entries {
+		if !now.Before(e.expires) {
+			delete(rc.entries, k)
+		}
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// acquire takes a slot of the max_concurrent_requests limiter, waiting until
+// one is free or ctx is done.
+func (c *autoglueClient) acquire(ctx context.Context) error {
+	select {
+	case c.limiter <- struct{}{}:
+		return nil
+	default:
+	}
+	tflog.Debug(ctx, "Waiting for a free Autoglue request slot", map[string]any{
+		"max_concurrent_requests": cap(c.limiter),
+	})
+	select {
+	case c.limiter <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *autoglueClient) release() {
+	<-c.limiter
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func countRequests(api *fakeAPI, line string) int {
+	n := 0
+	for _, l := range api.Requests() {
+		if l == line {
+			n++
+		}
+	}
+	return n
+}
+
+func TestReadCache_ClusterAttachments(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarnessWithConfig(t, map[string]any{
+		"base_url": api.URL(),
+		"api_key":  api.apiKey,
+		"org_id":   api.orgID,
+	})
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+	kc := h.resource("autoglue_cluster_kubeconfig")
+	kc.Apply(map[string]any{"cluster_id": clusterID, "kubeconfig": testKubeconfig})
+
+	// Any write empties the cache.
+	h.resource("autoglue_label").Apply(map[string]any{"key": "tier", "value": "web"})
+
+	get := "GET /clusters/" + clusterID
+	before := countRequests(api, get)
+	cluster.Refresh()
+	kc.Refresh()
+	if n := countRequests(api, get) - before; n != 1 {
+		t.Errorf("refreshing a cluster and its attachment made %d cluster reads, want 1", n)
+	}
+
+	kc.Destroy()
+	before = countRequests(api, get)
+	cluster.Refresh()
+	if n := countRequests(api, get) - before; n != 1 {
+		t.Errorf("expected a fresh cluster read after a write, got %d", n)
+	}
+}
+
+func TestReadCache_SecretsBypassCache(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarnessWithConfig(t, map[string]any{
+		"base_url": api.URL(),
+		"api_key":  api.apiKey,
+		"org_id":   api.orgID,
+	})
+
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "deploy", "type": "ed25519"})
+	id := key.Attr("id")
+
+	// Private key downloads are neither served from nor stored in the cache.
+	h.OpenEphemeralResource("autoglue_ssh_private_key", map[string]any{"id": id})
+	h.OpenEphemeralResource("autoglue_ssh_private_key", map[string]any{"id": id})
+	h.ReadDataSource("autoglue_ssh_key_download", map[string]any{"id": id, "part": "private"})
+	if n := countRequests(api, "GET /ssh/"+id+"/download?part=private"); n != 3 {
+		t.Errorf("3 private key downloads made %d requests, want 3", n)
+	}
+}
+
+func TestReadCache(t *testing.T) {
+	var hits atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			hits.Add(1)
+			<-release
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]string{"id": "c1"})
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := newAutoglueClient(clientConfig{BaseURL: srv.URL, APIKey: "k", OrgID: "o", ReadCacheTTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{ ID string }
+			if err := c.doJSON(ctx, http.MethodGet, "/clusters/c1", "", nil, &out); err != nil || out.ID != "c1" {
+				t.Errorf("GET = %+v, %v", out, err)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+	if n := hits.Load(); n != 1 {
+		t.Errorf("8 identical GETs made %d requests, want 1", n)
+	}
+
+	_ = c.doJSON(withFreshReads(ctx), http.MethodGet, "/clusters/c1", "", nil, nil)
+	if n := hits.Load(); n != 2 {
+		t.Errorf("fresh read served from cache")
+	}
+
+	_ = c.doJSON(ctx, http.MethodPost, "/clusters/c1/bastion", "", map[string]string{}, nil)
+	_ = c.doJSON(ctx, http.MethodGet, "/clusters/c1", "", nil, nil)
+	if n := hits.Load(); n != 3 {
+		t.Errorf("read after a write served from cache")
+	}
+
+	// Scoped requests don't share responses with other orgs.
+	_ = c.doJSON(withOrgScope(ctx, "o2"), http.MethodGet, "/clusters/c1", "", nil, nil)
+	if n := hits.Load(); n != 4 {
+		t.Errorf("read in another org served from cache")
+	}
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := newAutoglueClient(clientConfig{BaseURL: srv.URL, APIKey: "k", OrgID: "o", MaxConcurrentRequests: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Distinct paths, so nothing is coalesced.
+			if err := c.doJSON(context.Background(), http.MethodGet, "/servers/"+string(rune('a'+i)), "", nil, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if p := peak.Load(); p > 2 {
+		t.Errorf("peak concurrency %d, want at most 2", p)
+	}
+}
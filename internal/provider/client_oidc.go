@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultOIDCAudience = "autoglue"
+
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+
+	// tokenRefreshSkew is how long before expiry a cached bearer token is
+	// replaced, so requests in flight don't race its expiry. It is capped at
+	// a quarter of the token's lifetime so short-lived tokens are still reused.
+	tokenRefreshSkew = time.Minute
+	// defaultTokenLifetime is assumed for tokens issued without expires_in.
+	defaultTokenLifetime = 5 * time.Minute
+)
+
+// oidcConfig selects the workload identity token exchanged for an Autoglue
+// bearer token. At most one of Token, TokenFile and GitHubActions is set.
+type oidcConfig struct {
+	// ExchangeURL is the RFC 8693 token exchange endpoint. The published
+	// Autoglue API has none, so it must be configured explicitly.
+	ExchangeURL string
+	Token       string
+	// TokenFile is re-read on every exchange, so rotated tokens (e.g.
+	// Kubernetes projected service account tokens) are picked up.
+	TokenFile     string
+	GitHubActions bool
+	Audience      string
+}
+
+func (c oidcConfig) enabled() bool {
+	return c.Token != "" || c.TokenFile != "" || c.GitHubActions
+}
+
+func (c oidcConfig) validate() error {
+	n := 0
+	for _, set := range []bool{c.Token != "", c.TokenFile != "", c.GitHubActions} {
+		if set {
+			n++
+		}
+	}
+	if n > 1 {
+		return fmt.Errorf("only one of oidc_token, oidc_token_file or oidc_github_actions may be configured")
+	}
+	if n == 1 && c.ExchangeURL == "" {
+		return fmt.Errorf("oidc_token_exchange_url must be configured to use oidc_token, oidc_token_file or " +
+			"oidc_github_actions; the Autoglue API does not publish a token exchange endpoint")
+	}
+	return nil
+}
+
+// identityToken returns the workload identity token to exchange.
+func (c oidcConfig) identityToken(ctx context.Context, httpClient *http.Client) (string, error) {
+	switch {
+	case c.Token != "":
+		return c.Token, nil
+	case c.TokenFile != "":
+		b, err := os.ReadFile(c.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("reading oidc_token_file: %w", err)
+		}
+		return strings.TrimSpace(string(b)), nil
+	case c.GitHubActions:
+		return githubActionsIDToken(ctx, httpClient, c.audience())
+	}
+	return "", errors.New("no OIDC identity token configured")
+}
+
+func (c oidcConfig) audience() string {
+	if c.Audience != "" {
+		return c.Audience
+	}
+	return defaultOIDCAudience
+}
+
+// githubActionsIDToken requests an ID token from the GitHub Actions OIDC
+// provider. The job needs `permissions: id-token: write`.
+func githubActionsIDToken(ctx context.Context, httpClient *http.Client, audience string) (string, error) {
+	reqURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	reqToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if reqURL == "" || reqToken == "" {
+		return "", errors.New("ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set; " +
+			"oidc_github_actions only works inside a GitHub Actions job with `permissions: id-token: write`")
+	}
+
+	u, err := url.Parse(reqURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid ACTIONS_ID_TOKEN_REQUEST_URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("audience", audience)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("build GitHub Actions ID token request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+reqToken)
+	req.Header.Set("Accept", "application/json")
+
+	var out struct {
+		Value string `json:"value"`
+	}
+	if err := doRawJSON(httpClient, req, nil, nil, &out); err != nil {
+		return "", fmt.Errorf("requesting GitHub Actions ID token: %w", err)
+	}
+	if out.Value == "" {
+		return "", errors.New("requesting GitHub Actions ID token: empty token in response")
+	}
+	return out.Value, nil
+}
+
+type tokenExchangeRequest struct {
+	GrantType        string `json:"grant_type"`
+	SubjectToken     string `json:"subject_token"`
+	SubjectTokenType string `json:"subject_token_type"`
+	Audience         string `json:"audience,omitempty"`
+}
+
+type tokenExchangeResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// bearerTokenSource exchanges a workload identity token for an Autoglue
+// bearer token and caches it until shortly before it expires.
+type bearerTokenSource struct {
+	cfg        oidcConfig
+	headers    map[string]string
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu    sync.Mutex
+	token string
+	// refreshAt is when token is replaced, shortly before it expires.
+	refreshAt time.Time
+}
+
+// Token returns a valid bearer token, exchanging a new one if needed.
+func (s *bearerTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Before(s.refreshAt) {
+		return s.token, nil
+	}
+	return s.exchange(ctx)
+}
+
+// Invalidate drops token from the cache if it is still the current one, so
+// the next Token call exchanges a new one. Used after a 401.
+func (s *bearerTokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+func (s *bearerTokenSource) exchange(ctx context.Context) (string, error) {
+	subject, err := s.cfg.identityToken(ctx, s.httpClient)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(tokenExchangeRequest{
+		GrantType:        tokenExchangeGrantType,
+		SubjectToken:     subject,
+		SubjectTokenType: tokenTypeJWT,
+		Audience:         s.cfg.audience(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal token exchange request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.ExchangeURL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("build token exchange request: %w", err)
+	}
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	var out tokenExchangeResponse
+	if err := doRawJSON(s.httpClient, req, body, s.headers, &out); err != nil {
+		var apiErr *apiError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusMethodNotAllowed) {
+			return "", fmt.Errorf("%s does not support OIDC token exchange (POST returned %d); "+
+				"check oidc_token_exchange_url", s.cfg.ExchangeURL, apiErr.StatusCode)
+		}
+		return "", fmt.Errorf("exchanging OIDC token for an Autoglue bearer token: %w", err)
+	}
+	if out.AccessToken == "" {
+		return "", errors.New("exchanging OIDC token for an Autoglue bearer token: empty access_token in response")
+	}
+	if out.TokenType != "" && !strings.EqualFold(out.TokenType, "bearer") {
+		return "", fmt.Errorf("exchanging OIDC token for an Autoglue bearer token: unsupported token_type %q", out.TokenType)
+	}
+
+	// The token is opaque to the provider: the API validates it on every
+	// request, so its lifetime is taken from expires_in alone.
+	lifetime := defaultTokenLifetime
+	if out.ExpiresIn > 0 {
+		lifetime = time.Duration(out.ExpiresIn) * time.Second
+	}
+	now := s.now()
+	expires := now.Add(lifetime)
+
+	tflog.Debug(ctx, "Exchanged OIDC token for Autoglue bearer token", map[string]any{
+		"expires_at": expires.UTC().Format(time.RFC3339),
+	})
+
+	s.token, s.refreshAt = out.AccessToken, expires.Add(-min(tokenRefreshSkew, lifetime/4))
+	return s.token, nil
+}
+
+// doRawJSON performs req without the client's auth and retry handling and
+// decodes a JSON response into out. Like doOnce, it logs the request at DEBUG
+// with secrets and the values of staticHeaders masked; payload is req's body.
+func doRawJSON(httpClient *http.Client, req *http.Request, payload []byte, staticHeaders map[string]string, out any) error {
+	var values []string
+	for _, v := range staticHeaders {
+		values = append(values, v)
+	}
+	ctx := withLogMasks(req.Context(), values...)
+
+	trace := requestTrace{
+		host:          req.URL.Host,
+		method:        req.Method,
+		path:          req.URL.Path,
+		query:         req.URL.RawQuery,
+		attempt:       1,
+		correlationID: req.Header.Get(correlationIDHeader),
+		header:        req.Header,
+		staticHeaders: staticHeaders,
+		payload:       payload,
+		start:         time.Now(),
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		trace.err = err
+		logRequest(ctx, trace)
+		return fmt.Errorf("perform request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	trace.resp, trace.respBody, trace.err = resp, body, err
+	logRequest(ctx, trace)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &apiError{StatusCode: resp.StatusCode, Body: string(body), Method: req.Method, Path: req.URL.Path}
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newOIDCTestClient(t *testing.T, api *fakeAPI, oidc oidcConfig) *autoglueClient {
+	t.Helper()
+	oidc.ExchangeURL = api.TokenExchangeURL()
+	c, err := newAutoglueClient(clientConfig{
+		BaseURL:          api.URL(),
+		OrgID:            api.orgID,
+		OIDC:             oidc,
+		RetryMaxAttempts: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func (f *fakeAPI) tokenExchangeCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.tokenExchanges
+}
+
+func TestProvider_OIDC(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarnessWithConfig(t, map[string]any{
+		"base_url":                api.URL(),
+		"org_id":                  api.orgID,
+		"oidc_token":              api.oidcSubjectToken,
+		"oidc_token_exchange_url": api.TokenExchangeURL(),
+		"read_cache_ttl":          "0s",
+	})
+
+	h.ReadDataSource("autoglue_ssh_keys", map[string]any{})
+	h.ReadDataSource("autoglue_ssh_keys", map[string]any{})
+	if n := api.tokenExchangeCount(); n != 1 {
+		t.Fatalf("expected the bearer token to be cached, got %d exchanges", n)
+	}
+
+	// A revoked token is replaced transparently on the 401.
+	api.revokeBearerTokens()
+	h.ReadDataSource("autoglue_ssh_keys", map[string]any{})
+	if n := api.tokenExchangeCount(); n != 2 {
+		t.Fatalf("expected a new exchange after a 401, got %d exchanges", n)
+	}
+
+	newUnconfiguredHarness(t).ConfigureExpectError(map[string]any{
+		"base_url":                api.URL(),
+		"org_id":                  api.orgID,
+		"bearer_token":            "static",
+		"oidc_token":              api.oidcSubjectToken,
+		"oidc_token_exchange_url": api.TokenExchangeURL(),
+	}, "bearer_token cannot be combined")
+
+	// The API has no token exchange endpoint of its own to fall back to.
+	newUnconfiguredHarness(t).ConfigureExpectError(map[string]any{
+		"base_url":   api.URL(),
+		"org_id":     api.orgID,
+		"oidc_token": api.oidcSubjectToken,
+	}, "oidc_token_exchange_url must be configured")
+
+	h = newTestHarnessWithConfig(t, map[string]any{
+		"base_url":                api.URL(),
+		"org_id":                  api.orgID,
+		"oidc_token":              "someone-elses-token",
+		"oidc_token_exchange_url": api.TokenExchangeURL(),
+	})
+	h.ReadDataSourceExpectError("autoglue_ssh_keys", map[string]any{}, "invalid subject token")
+}
+
+func TestBearerTokenSource_RefreshBeforeExpiry(t *testing.T) {
+	api := newFakeAPI(t)
+	c := newOIDCTestClient(t, api, oidcConfig{Token: api.oidcSubjectToken})
+	ctx := context.Background()
+
+	now := time.Now()
+	c.tokenSource.now = func() time.Time { return now }
+
+	first, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(30 * time.Minute)
+	if again, _ := c.tokenSource.Token(ctx); again != first {
+		t.Errorf("token refreshed while still valid")
+	}
+
+	// Within tokenRefreshSkew of expiry, a new token is exchanged.
+	now = now.Add(29*time.Minute + 30*time.Second)
+	refreshed, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed == first {
+		t.Errorf("token not refreshed before expiry")
+	}
+	if n := api.tokenExchangeCount(); n != 2 {
+		t.Errorf("expected 2 exchanges, got %d", n)
+	}
+}
+
+func TestBearerTokenSource_ShortLivedToken(t *testing.T) {
+	api := newFakeAPI(t)
+	api.bearerTokenTTL = 30 * time.Second
+	c := newOIDCTestClient(t, api, oidcConfig{Token: api.oidcSubjectToken})
+	ctx := context.Background()
+
+	now := time.Now()
+	c.tokenSource.now = func() time.Time { return now }
+
+	// A token shorter-lived than tokenRefreshSkew is still reused for most
+	// of its lifetime instead of being exchanged on every request.
+	first, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(20 * time.Second)
+	if again, _ := c.tokenSource.Token(ctx); again != first {
+		t.Errorf("short-lived token refreshed after 20s of 30s")
+	}
+	now = now.Add(5 * time.Second)
+	if refreshed, _ := c.tokenSource.Token(ctx); refreshed == first {
+		t.Errorf("short-lived token not refreshed within a quarter of its lifetime of expiry")
+	}
+	if n := api.tokenExchangeCount(); n != 2 {
+		t.Errorf("expected 2 exchanges, got %d", n)
+	}
+}
+
+func TestBearerTokenSource_TokenFile(t *testing.T) {
+	api := newFakeAPI(t)
+	file := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(file, []byte("stale-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := newOIDCTestClient(t, api, oidcConfig{TokenFile: file})
+	ctx := context.Background()
+
+	if err := c.doJSON(ctx, http.MethodGet, "/ssh", "", nil, nil); err == nil || !strings.Contains(err.Error(), "invalid subject token") {
+		t.Fatalf("expected the stale token to be rejected, got %v", err)
+	}
+
+	// The file is re-read on the next exchange.
+	if err := os.WriteFile(file, []byte(api.oidcSubjectToken+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/ssh", "", nil, nil); err != nil {
+		t.Fatalf("rotated token file: %s", err)
+	}
+}
+
+func TestBearerTokenSource_GitHubActions(t *testing.T) {
+	api := newFakeAPI(t)
+	gh := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("audience") != "autoglue" || r.URL.Query().Get("api-version") != "2.0" {
+			t.Errorf("unexpected ID token request %s", r.URL)
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]string{"value": api.oidcSubjectToken})
+	}))
+	t.Cleanup(gh.Close)
+
+	c := newOIDCTestClient(t, api, oidcConfig{GitHubActions: true})
+	ctx := context.Background()
+	if err := c.doJSON(ctx, http.MethodGet, "/ssh", "", nil, nil); err == nil || !strings.Contains(err.Error(), "ACTIONS_ID_TOKEN_REQUEST_URL") {
+		t.Fatalf("expected an error outside GitHub Actions, got %v", err)
+	}
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", gh.URL+"/token?api-version=2.0")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+	if err := c.doJSON(ctx, http.MethodGet, "/ssh", "", nil, nil); err != nil {
+		t.Fatalf("GitHub Actions exchange: %s", err)
+	}
+}
+
+func TestBearerTokenSource_ExchangeUnsupported(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusMethodNotAllowed} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeFakeError(w, status, "not found")
+		}))
+		t.Cleanup(srv.Close)
+
+		c, err := newAutoglueClient(clientConfig{
+			BaseURL:          srv.URL,
+			OrgID:            "org",
+			OIDC:             oidcConfig{Token: "token", ExchangeURL: srv.URL + "/token"},
+			RetryMaxAttempts: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = c.doJSON(context.Background(), http.MethodGet, "/ssh", "", nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "does not support OIDC token exchange") {
+			t.Errorf("status %d: expected an unsupported exchange error, got %v", status, err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxListPages guards against a server that keeps handing out next pages.
+const maxListPages = 1000
+
+// listPage is the envelope paginated list endpoints wrap their items in.
+// Endpoints that don't paginate return a bare JSON array instead.
+type listPage struct {
+	Items      json.RawMessage `json:"items"`
+	Data       json.RawMessage `json:"data"`
+	Next       string          `json:"next"`
+	NextCursor string          `json:"next_cursor"`
+	Page       int             `json:"page"`
+	TotalPages int             `json:"total_pages"`
+}
+
+// listAll GETs a collection endpoint and follows pagination until every item
+// has been collected. The next page is taken from, in order of preference, a
+// Link header with rel="next", the envelope's next URL, its next_cursor
+// (sent back as ?cursor=) or its page/total_pages counters (sent as ?page=).
+// A bare array response is treated as a single, complete page.
+func listAll[T any](ctx context.Context, c *autoglueClient, path string, query url.Values) ([]T, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = append([]string(nil), v...)
+	}
+
+	reqPath, reqQuery := path, q.Encode()
+	seen := map[string]bool{}
+	var all []T
+
+	for pages := 0; ; pages++ {
+		if pages >= maxListPages {
+			return nil, fmt.Errorf("listing %s: gave up after %d pages", path, maxListPages)
+		}
+		key := reqPath + "?" + reqQuery
+		if seen[key] {
+			return nil, fmt.Errorf("listing %s: server returned a next page that was already fetched (%s)", path, key)
+		}
+		seen[key] = true
+
+		var raw json.RawMessage
+		header, err := c.doJSONWithHeaders(ctx, http.MethodGet, reqPath, reqQuery, nil, &raw)
+		if err != nil {
+			return nil, err
+		}
+
+		items, page, err := decodeListPage[T](raw)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", path, err)
+		}
+		all = append(all, items...)
+
+		next := nextLink(header.Values("Link"))
+		if next == "" {
+			next = page.Next
+		}
+		switch {
+		case next != "":
+			reqPath, reqQuery, err = c.relativeRef(next)
+			if err != nil {
+				return nil, fmt.Errorf("listing %s: %w", path, err)
+			}
+		case page.NextCursor != "":
+			q.Set("cursor", page.NextCursor)
+			reqQuery = q.Encode()
+		case page.Page > 0 && page.Page < page.TotalPages:
+			q.Set("page", strconv.Itoa(page.Page+1))
+			reqQuery = q.Encode()
+		default:
+			return all, nil
+		}
+	}
+}
+
+// decodeListPage decodes one list response, either a bare array or a listPage envelope.
+func decodeListPage[T any](raw json.RawMessage) ([]T, listPage, error) {
+	var page listPage
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, page, nil
+	}
+
+	if raw[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, page, fmt.Errorf("decode response: %w", err)
+		}
+		return items, page, nil
+	}
+
+	if err := json.Unmarshal(raw, &page); err != nil {
+		return nil, page, fmt.Errorf("decode response: %w", err)
+	}
+	body := page.Items
+	if len(body) == 0 {
+		body = page.Data
+	}
+	var items []T
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &items); err != nil {
+			return nil, page, fmt.Errorf("decode response items: %w", err)
+		}
+	}
+	return items, page, nil
+}
+
+// nextLink returns the target of the rel="next" entry in RFC 8288 Link headers.
+func nextLink(headers []string) string {
+	for _, h := range headers {
+		for _, link := range strings.Split(h, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, p := range parts[1:] {
+				name, value, ok := strings.Cut(strings.TrimSpace(p), "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(name), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+					if strings.EqualFold(rel, "next") {
+						return target[1 : len(target)-1]
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// relativeRef resolves a (possibly relative) URL returned by the API against
+// the base URL and splits it into the path and query doJSON expects.
+func (c *autoglueClient) relativeRef(ref string) (string, string, error) {
+	base, err := url.Parse(c.baseURL + "/")
+	if err != nil {
+		return "", "", fmt.Errorf("parse base URL: %w", err)
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return "", "", fmt.Errorf("parse next page URL %q: %w", ref, err)
+	}
+
+	basePath := strings.TrimSuffix(base.Path, "/")
+	if !strings.EqualFold(u.Host, base.Host) || !strings.HasPrefix(u.Path, basePath+"/") {
+		return "", "", fmt.Errorf("next page URL %q is outside of %s", ref, c.baseURL)
+	}
+	return strings.TrimPrefix(u.Path, basePath), u.RawQuery, nil
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newPaginateTestClient(t *testing.T, handler http.HandlerFunc) *autoglueClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	c, err := newAutoglueClient(clientConfig{BaseURL: srv.URL + "/api/v1", APIKey: "k", OrgID: "o"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func writePage(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestListAll_Cursor(t *testing.T) {
+	c := newPaginateTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("role") != "worker" {
+			t.Errorf("filter not forwarded: %s", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			writePage(w, map[string]any{"items": []string{"a", "b"}, "next_cursor": "c2"})
+		case "c2":
+			writePage(w, map[string]any{"items": []string{"c"}, "next_cursor": ""})
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	got, err := listAll[string](context.Background(), c, "/servers", url.Values{"role": {"worker"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestListAll_PageCounters(t *testing.T) {
+	c := newPaginateTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			writePage(w, map[string]any{"data": []string{"a"}, "page": 1, "total_pages": 2})
+		case "2":
+			writePage(w, map[string]any{"data": []string{"b"}, "page": 2, "total_pages": 2})
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	got, err := listAll[string](context.Background(), c, "/labels", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestListAll_LinkHeader(t *testing.T) {
+	c := newPaginateTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/ssh" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("after") == "" {
+			w.Header().Set("Link", `</api/v1/ssh?after=a>; rel="next", </api/v1/ssh?after=z>; rel="last"`)
+			writePage(w, []string{"a"})
+			return
+		}
+		writePage(w, []string{"b"})
+	})
+
+	got, err := listAll[string](context.Background(), c, "/ssh", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestListAll_RepeatedPage(t *testing.T) {
+	c := newPaginateTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, map[string]any{"items": []string{"a"}, "next_cursor": "same"})
+	})
+
+	_, err := listAll[string](context.Background(), c, "/servers", nil)
+	if err == nil || !strings.Contains(err.Error(), "already fetched") {
+		t.Fatalf("expected repeated page error, got %v", err)
+	}
+}
+
+func TestListAll_LinkOutsideBaseURL(t *testing.T) {
+	c := newPaginateTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://elsewhere.example/api/v1/servers?page=2>; rel="next"`)
+		writePage(w, []string{"a"})
+	})
+
+	_, err := listAll[string](context.Background(), c, "/servers", nil)
+	if err == nil || !strings.Contains(err.Error(), "outside of") {
+		t.Fatalf("expected outside-of-base error, got %v", err)
+	}
+}
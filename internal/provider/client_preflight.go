@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// serverVersion is the API's build information from GET /version
+// (see datasource_version).
+type serverVersion struct {
+	Version  string `json:"version"`
+	Commit   string `json:"commit"`
+	Built    string `json:"built"`
+	Modified bool   `json:"modified"`
+}
+
+// preflightError is a failed preflight check, reported as a single
+// diagnostic on the provider argument most likely at fault.
+type preflightError struct {
+	attribute path.Path
+	summary   string
+	detail    string
+}
+
+// preflight checks, in order, that base_url reaches a healthy Autoglue API,
+// that the credentials are accepted and that the caller is a member of
+// org_id, so misconfiguration fails once during Configure instead of on
+// every resource. The server version is recorded on the client.
+func (c *autoglueClient) preflight(ctx context.Context) *preflightError {
+	var health struct {
+		Status string `json:"status"`
+	}
+	if err := c.getUnauthenticated(ctx, "/healthz", &health); err != nil {
+		return c.connectivityError(err)
+	}
+	if health.Status != "" && !strings.EqualFold(health.Status, "ok") {
+		return &preflightError{
+			attribute: path.Root("base_url"),
+			summary:   "Autoglue API is unhealthy",
+			detail:    fmt.Sprintf("GET %s/healthz reported status %q. Try again later.", c.baseURL, health.Status),
+		}
+	}
+
+	// Older servers may not expose /version; the version is only logged, so
+	// that isn't fatal.
+	var version serverVersion
+	if err := c.getUnauthenticated(ctx, "/version", &version); err != nil {
+		tflog.Warn(ctx, "Unable to read Autoglue server version", map[string]any{"error": err.Error()})
+	} else {
+		c.serverVersion = version.Version
+		tflog.Info(ctx, "Autoglue server version", map[string]any{
+			"version": version.Version,
+			"commit":  version.Commit,
+		})
+	}
+
+	// Org credentials are bound to their organization and can't call /me;
+	// they are checked by the first request instead.
+	if !c.sendOrgHeader {
+		return nil
+	}
+
+	orgs, err := c.memberOrgs(ctx)
+	var ae *apiError
+	switch {
+	case errors.As(err, &ae) && ae.StatusCode == http.StatusUnauthorized:
+		return &preflightError{
+			attribute: c.credentialsAttribute(),
+			summary:   "Autoglue credentials rejected",
+			detail: fmt.Sprintf("The API at %s rejected the configured %s (HTTP 401). "+
+				"Check that it is correct, has not expired or been revoked, and belongs to this Autoglue instance.",
+				c.baseURL, c.credentialsAttribute()),
+		}
+	case errors.As(err, &ae) && ae.StatusCode == http.StatusForbidden:
+		return &preflightError{
+			attribute: path.Root("org_id"),
+			summary:   "Access to organization denied",
+			detail: fmt.Sprintf("The credentials are valid, but the API denied access to organization %q: %s. "+
+				"Check org_id.", c.orgID, ae.Body),
+		}
+	case err != nil:
+		return &preflightError{
+			attribute: c.credentialsAttribute(),
+			summary:   "Unable to authenticate with Autoglue",
+			detail:    fmt.Sprintf("Verifying the configured %s failed: %s", c.credentialsAttribute(), err),
+		}
+	}
+
+	if _, ok := orgs[c.orgID]; !ok {
+		available := make([]string, 0, len(orgs))
+		for id, name := range orgs {
+			available = append(available, fmt.Sprintf("%s (%s)", id, name))
+		}
+		sort.Strings(available)
+		detail := fmt.Sprintf("The credentials are valid, but you are not a member of organization %q.", c.orgID)
+		if len(available) > 0 {
+			detail += " Your organizations: " + strings.Join(available, ", ") + "."
+		}
+		return &preflightError{
+			attribute: path.Root("org_id"),
+			summary:   "Not a member of organization",
+			detail:    detail,
+		}
+	}
+	return nil
+}
+
+// connectivityError explains a failed unauthenticated request to base_url.
+func (c *autoglueClient) connectivityError(err error) *preflightError {
+	var ae *apiError
+	if errors.As(err, &ae) {
+		if ae.StatusCode == http.StatusNotFound {
+			return &preflightError{
+				attribute: path.Root("base_url"),
+				summary:   "base_url is not an Autoglue API",
+				detail: fmt.Sprintf("GET %s/healthz returned 404. base_url must include the API path, "+
+					"e.g. https://autoglue.example.com/api/v1.", c.baseURL),
+			}
+		}
+		return &preflightError{
+			attribute: path.Root("base_url"),
+			summary:   "Autoglue API is unhealthy",
+			detail:    fmt.Sprintf("GET %s/healthz failed: %s", c.baseURL, err),
+		}
+	}
+
+	detail := fmt.Sprintf("GET %s/healthz failed: %s. Check base_url, network access and proxy_url.", c.baseURL, err)
+	var unknownAuthority x509.UnknownAuthorityError
+	var invalidCert x509.CertificateInvalidError
+	var hostname x509.HostnameError
+	if errors.As(err, &unknownAuthority) || errors.As(err, &invalidCert) || errors.As(err, &hostname) {
+		detail = fmt.Sprintf("GET %s/healthz failed TLS verification: %s. "+
+			"If the API uses a private CA, set ca_cert_pem or ca_cert_file.", c.baseURL, err)
+	}
+	if strings.Contains(err.Error(), "decode response") {
+		detail = fmt.Sprintf("GET %s/healthz did not return JSON. base_url must include the API path, "+
+			"e.g. https://autoglue.example.com/api/v1.", c.baseURL)
+	}
+	return &preflightError{
+		attribute: path.Root("base_url"),
+		summary:   "Unable to reach the Autoglue API",
+		detail:    detail,
+	}
+}
+
+// credentialsAttribute is the provider argument holding the credentials in use.
+func (c *autoglueClient) credentialsAttribute() path.Path {
+	switch {
+	case c.apiKey != "":
+		return path.Root("api_key")
+	case c.tokenSource != nil && c.tokenSource.cfg.TokenFile != "":
+		return path.Root("oidc_token_file")
+	case c.tokenSource != nil && c.tokenSource.cfg.GitHubActions:
+		return path.Root("oidc_github_actions")
+	case c.tokenSource != nil:
+		return path.Root("oidc_token")
+	case c.bearerToken != "":
+		return path.Root("bearer_token")
+	}
+	return path.Root("org_key")
+}
+
+// getUnauthenticated GETs a public endpoint once, without credentials or retries.
+func (c *autoglueClient) getUnauthenticated(ctx context.Context, p string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+p, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set(correlationIDHeader, c.correlationID)
+	req.Header.Set("Accept", "application/json")
+	return doRawJSON(c.httpClient, req, nil, c.headers, out)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProvider_Preflight(t *testing.T) {
+	api := newFakeAPI(t)
+	config := func(overrides map[string]any) map[string]any {
+		cfg := map[string]any{
+			"base_url":  api.URL(),
+			"api_key":   api.apiKey,
+			"org_id":    api.orgID,
+			"preflight": true,
+		}
+		for k, v := range overrides {
+			cfg[k] = v
+		}
+		return cfg
+	}
+
+	h := newTestHarnessWithConfig(t, config(nil))
+	h.ReadDataSource("autoglue_labels", nil)
+
+	newUnconfiguredHarness(t).ConfigureExpectError(config(map[string]any{"api_key": "wrong"}),
+		"Autoglue credentials rejected")
+	newUnconfiguredHarness(t).ConfigureExpectError(config(map[string]any{"org_id": "44444444-4444-4444-4444-444444444444"}),
+		"Your organizations: 11111111-1111-1111-1111-111111111111 (Test Org)")
+
+	notAPI := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(notAPI.Close)
+	newUnconfiguredHarness(t).ConfigureExpectError(config(map[string]any{"base_url": notAPI.URL}),
+		"base_url must include the API path")
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	newUnconfiguredHarness(t).ConfigureExpectError(config(map[string]any{"base_url": down.URL}),
+		"Unable to reach the Autoglue API")
+
+	// Without preflight, bad credentials only fail on use.
+	h = newTestHarnessWithConfig(t, config(map[string]any{"api_key": "wrong", "preflight": false}))
+	h.ReadDataSourceExpectError("autoglue_labels", nil, "invalid api key")
+}
+
+func TestClientPreflight(t *testing.T) {
+	api := newFakeAPI(t)
+	c, err := newAutoglueClient(clientConfig{BaseURL: api.URL(), APIKey: api.apiKey, OrgID: api.orgID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if perr := c.preflight(context.Background()); perr != nil {
+		t.Fatalf("preflight: %s: %s", perr.summary, perr.detail)
+	}
+	if c.serverVersion != api.version {
+		t.Errorf("serverVersion = %q, want %q", c.serverVersion, api.version)
+	}
+
+	api.healthStatus = "degraded"
+	perr := c.preflight(context.Background())
+	if perr == nil || perr.summary != "Autoglue API is unhealthy" {
+		t.Errorf("expected an unhealthy API to fail preflight, got %+v", perr)
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryMaxWait     = 30 * time.Second
+
+	retryBaseDelay = 500 * time.Millisecond
+)
+
+// retryPolicy controls how doJSON retries throttled and transiently failing requests.
+type retryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MaxWait caps any single wait between attempts. A server-requested
+	// wait (Retry-After / X-RateLimit-Reset) longer than this aborts the retry loop.
+	MaxWait time.Duration
+}
+
+func (p retryPolicy) withDefaults() retryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if p.MaxWait <= 0 {
+		p.MaxWait = defaultRetryMaxWait
+	}
+	return p
+}
+
+// isIdempotentMethod reports whether a request with this method can be safely
+// replayed after a transport error or gateway failure.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDecision inspects the outcome of a single attempt and decides whether
+// another attempt should be made. serverWait is true when the delay was
+// dictated by the API (Retry-After / X-RateLimit-Reset) rather than computed locally.
+func (p retryPolicy) retryDecision(method string, attempt int, err error, now time.Time) (retry bool, wait time.Duration, serverWait bool) {
+	if attempt+1 >= p.MaxAttempts {
+		return false, 0, false
+	}
+
+	var ae *apiError
+	if !errors.As(err, &ae) {
+		// Transport-level failure: the request may or may not have reached the API.
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false, 0, false
+		}
+		if !isIdempotentMethod(method) {
+			return false, 0, false
+		}
+		return true, p.backoff(attempt), false
+	}
+
+	switch ae.StatusCode {
+	case http.StatusTooManyRequests:
+		// The request was rejected before being processed, so replaying it is
+		// safe for every method.
+	case http.StatusServiceUnavailable:
+		// Non-idempotent requests are only replayed when the API explicitly
+		// asks for it via Retry-After.
+		if !isIdempotentMethod(method) && ae.RetryAfter == "" {
+			return false, 0, false
+		}
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		if !isIdempotentMethod(method) {
+			return false, 0, false
+		}
+	default:
+		return false, 0, false
+	}
+
+	if d, ok := ae.serverRetryDelay(now); ok {
+		return true, d, true
+	}
+	return true, p.backoff(attempt), false
+}
+
+// backoff returns a jittered exponential delay for the given zero-based attempt,
+// capped at MaxWait ("equal jitter": uniform in [d/2, d] for
+// d = min(MaxWait, base*2^attempt)), so retries never fire back to back.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	ceiling := retryBaseDelay << attempt
+	if ceiling <= 0 || ceiling > p.MaxWait {
+		ceiling = p.MaxWait
+	}
+	half := ceiling / 2
+	return half + rand.N(half+1)
+}
+
+// serverRetryDelay extracts the wait requested by the API, preferring
+// Retry-After over X-RateLimit-Reset.
+func (e *apiError) serverRetryDelay(now time.Time) (time.Duration, bool) {
+	if d, ok := parseRetryAfter(e.RetryAfter, now); ok {
+		return d, true
+	}
+	if d, ok := parseRateLimitReset(e.RateLimitReset, now); ok {
+		return d, true
+	}
+	return 0, false
+}
+
+// parseRetryAfter accepts both forms allowed by RFC 9110: delay-seconds and HTTP-date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return nonNegative(t.Sub(now)), true
+	}
+	return 0, false
+}
+
+// parseRateLimitReset accepts either a unix timestamp or a number of seconds
+// until the window resets; values that look like epoch seconds are treated as such.
+func parseRateLimitReset(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	if n > 1_000_000_000 {
+		return nonNegative(time.Unix(n, 0).Sub(now)), true
+	}
+	return time.Duration(n) * time.Second, true
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// sleepWithContext waits for d, returning early with an error if ctx is done
+// or if its deadline would expire before the wait completes.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return fmt.Errorf("retry wait of %s exceeds remaining context deadline", d)
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
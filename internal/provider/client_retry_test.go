@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	cases := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"7", 7 * time.Second, true},
+		{" 3 ", 3 * time.Second, true},
+		{"-2", 0, true},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"soon", 0, false},
+	}
+	for _, tc := range cases {
+		got, ok := parseRetryAfter(tc.in, now)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("parseRetryAfter(%q) = %s, %v; want %s, %v", tc.in, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	cases := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"12", 12 * time.Second, true},
+		{"1700000030", 30 * time.Second, true},
+		{"1699999990", 0, true},
+		{"-1", 0, false},
+		{"x", 0, false},
+	}
+	for _, tc := range cases {
+		got, ok := parseRateLimitReset(tc.in, now)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("parseRateLimitReset(%q) = %s, %v; want %s, %v", tc.in, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestRetryDecision(t *testing.T) {
+	p := retryPolicy{MaxAttempts: 4, MaxWait: 30 * time.Second}
+	now := time.Now()
+	status := func(code int, retryAfter string) error {
+		return &apiError{StatusCode: code, RetryAfter: retryAfter}
+	}
+
+	cases := []struct {
+		name       string
+		method     string
+		attempt    int
+		err        error
+		retry      bool
+		serverWait time.Duration // checked when > 0
+	}{
+		{"429 GET", http.MethodGet, 0, status(429, ""), true, 0},
+		{"429 POST", http.MethodPost, 0, status(429, ""), true, 0},
+		{"429 honors Retry-After", http.MethodPost, 0, status(429, "4"), true, 4 * time.Second},
+		{"503 GET", http.MethodGet, 0, status(503, ""), true, 0},
+		{"503 POST", http.MethodPost, 0, status(503, ""), false, 0},
+		{"503 POST with Retry-After", http.MethodPost, 0, status(503, "2"), true, 2 * time.Second},
+		{"502 DELETE", http.MethodDelete, 0, status(502, ""), true, 0},
+		{"502 POST", http.MethodPost, 0, status(502, ""), false, 0},
+		{"504 PATCH", http.MethodPatch, 0, status(504, ""), false, 0},
+		{"500 GET", http.MethodGet, 0, status(500, ""), false, 0},
+		{"404 GET", http.MethodGet, 0, status(404, ""), false, 0},
+		{"network error GET", http.MethodGet, 0, errors.New("connection reset"), true, 0},
+		{"network error POST", http.MethodPost, 0, errors.New("connection reset"), false, 0},
+		{"canceled", http.MethodGet, 0, context.Canceled, false, 0},
+		{"deadline", http.MethodGet, 0, context.DeadlineExceeded, false, 0},
+		{"last attempt", http.MethodGet, 3, status(429, ""), false, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			retry, wait, serverWait := p.retryDecision(tc.method, tc.attempt, tc.err, now)
+			if retry != tc.retry {
+				t.Fatalf("retry = %v, want %v", retry, tc.retry)
+			}
+			if !retry {
+				return
+			}
+			if tc.serverWait > 0 {
+				if !serverWait || wait != tc.serverWait {
+					t.Errorf("wait = %s (server %v), want server-requested %s", wait, serverWait, tc.serverWait)
+				}
+			} else if serverWait {
+				t.Errorf("wait %s reported as server-requested", wait)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := retryPolicy{MaxAttempts: 10, MaxWait: 3 * time.Second}
+	for attempt := range 8 {
+		ceiling := min(retryBaseDelay<<attempt, p.MaxWait)
+		for range 50 {
+			d := p.backoff(attempt)
+			if d < ceiling/2 || d > ceiling {
+				t.Fatalf("backoff(%d) = %s, want within [%s, %s]", attempt, d, ceiling/2, ceiling)
+			}
+		}
+	}
+	// Large attempts must not overflow into a negative or zero delay.
+	if d := p.backoff(62); d < p.MaxWait/2 || d > p.MaxWait {
+		t.Errorf("backoff(62) = %s, want within [%s, %s]", d, p.MaxWait/2, p.MaxWait)
+	}
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// correlationIDHeader carries the provider run's ID on every request, so
+	// server logs can be matched to a Terraform run.
+	correlationIDHeader = "X-Correlation-ID"
+	// requestIDHeader is the server-assigned ID of a single request.
+	requestIDHeader = "X-Request-ID"
+
+	// maxLoggedBodyBytes bounds the request and response bodies included in
+	// request log entries.
+	maxLoggedBodyBytes = 4096
+
+	redacted = "***"
+)
+
+// secretLogFields are JSON body fields and log field keys whose values are
+// never logged.
+var secretLogFields = []string{
+	"secret",
+	"kubeconfig",
+	"org_key",
+	"org_secret",
+	"plain",
+	"private_key",
+	"certificate_key",
+	"random_token",
+	"token",
+	"access_token",
+	"subject_token",
+	"api_key",
+	"bearer_token",
+	"password",
+}
+
+// secretLogHeaders are request headers whose values are never logged. The
+// values of configured static headers are masked as well, since they often
+// carry credentials for a gateway in front of the API.
+var secretLogHeaders = []string{"Authorization", "X-Api-Key", "X-Org-Key", "X-Org-Secret", "Cookie"}
+
+var (
+	privateKeyPattern  = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z0-9 ]*PRIVATE KEY-----`)
+	bearerTokenPattern = regexp.MustCompile(`Bearer [A-Za-z0-9\-._~+/]+=*`)
+	// jwtPattern matches OIDC identity tokens, which are logged without a
+	// Bearer prefix by the GitHub Actions token endpoint.
+	jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+)
+
+// newCorrelationID returns a random UUID identifying this provider run.
+func newCorrelationID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// logContext returns ctx with masks that keep credentials, static header
+// values and secret fields out of every log entry written with it.
+func (c *autoglueClient) logContext(ctx context.Context, bearerToken string) context.Context {
+	values := []string{c.apiKey, c.orgKey, c.orgSecret, bearerToken}
+	for _, v := range c.headers {
+		values = append(values, v)
+	}
+	return withLogMasks(ctx, values...)
+}
+
+// withLogMasks returns ctx with masks for secret fields, private keys, bearer
+// tokens and the given values. Empty values are ignored.
+func withLogMasks(ctx context.Context, values ...string) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, secretLogFields...)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, privateKeyPattern, bearerTokenPattern, jwtPattern)
+	ctx = tflog.MaskMessageRegexes(ctx, privateKeyPattern, bearerTokenPattern, jwtPattern)
+
+	var masked []string
+	for _, v := range values {
+		if v != "" {
+			masked = append(masked, v)
+		}
+	}
+	if len(masked) > 0 {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, masked...)
+		ctx = tflog.MaskMessageStrings(ctx, masked...)
+	}
+	return ctx
+}
+
+// requestTrace describes one attempt of an API request for logging.
+type requestTrace struct {
+	// host is set for requests made outside doJSON, such as the OIDC token
+	// exchange, which may go to endpoints other than base_url.
+	host    string
+	method  string
+	path    string
+	query   string
+	attempt int
+	// correlationID is the provider run's ID, if the request carries one.
+	correlationID string
+	header        http.Header
+	// staticHeaders are the configured static headers, whose values are
+	// never logged.
+	staticHeaders map[string]string
+	payload       []byte
+	start         time.Time
+	resp          *http.Response
+	respBody      []byte
+	err           error
+}
+
+// logRequest writes a structured DEBUG entry for one request attempt.
+func logRequest(ctx context.Context, t requestTrace) {
+	fields := map[string]any{
+		"method":          t.method,
+		"path":            t.path,
+		"query":           t.query,
+		"attempt":         t.attempt,
+		"latency_ms":      time.Since(t.start).Milliseconds(),
+		"correlation_id":  t.correlationID,
+		"request_headers": redactHeaders(t.header, t.staticHeaders),
+	}
+	if t.host != "" {
+		fields["host"] = t.host
+	}
+	if t.payload != nil {
+		fields["request_body"] = redactBody(t.payload)
+	}
+	if t.err != nil {
+		fields["error"] = t.err.Error()
+	}
+	if t.resp != nil {
+		fields["status"] = t.resp.StatusCode
+		fields["request_id"] = t.resp.Header.Get(requestIDHeader)
+		fields["response_body"] = redactBody(t.respBody)
+	}
+	tflog.Debug(ctx, "Autoglue API request", fields)
+}
+
+// redactHeaders flattens h for logging, masking credential headers and the
+// configured static headers.
+func redactHeaders(h http.Header, static map[string]string) map[string]string {
+	out := make(map[string]string, len(h))
+	for name, values := range h {
+		out[name] = strings.Join(values, ", ")
+	}
+	mask := func(name string) {
+		if _, ok := out[name]; ok {
+			out[name] = redacted
+		}
+	}
+	for _, name := range secretLogHeaders {
+		mask(name)
+	}
+	for name := range static {
+		// The provider always replaces a configured correlation ID.
+		if name = http.CanonicalHeaderKey(name); name != http.CanonicalHeaderKey(correlationIDHeader) {
+			mask(name)
+		}
+	}
+	return out
+}
+
+// redactBody masks secretLogFields in a JSON body and truncates it to
+// maxLoggedBodyBytes. Bodies that aren't JSON are only truncated; the log
+// masks still catch private keys and credentials in them.
+func redactBody(body []byte) string {
+	s := string(body)
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err == nil {
+		if b, err := json.Marshal(redactJSON(v)); err == nil {
+			s = string(b)
+		}
+	}
+	if len(s) > maxLoggedBodyBytes {
+		s = fmt.Sprintf("%s... (%d bytes truncated)", s[:maxLoggedBodyBytes], len(s)-maxLoggedBodyBytes)
+	}
+	return s
+}
+
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, elem := range v {
+			if isSecretLogField(k) {
+				if elem != nil && elem != "" {
+					v[k] = redacted
+				}
+				continue
+			}
+			v[k] = redactJSON(elem)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = redactJSON(elem)
+		}
+	}
+	return v
+}
+
+func isSecretLogField(key string) bool {
+	for _, f := range secretLogFields {
+		if strings.EqualFold(key, f) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultRequestTimeout = 60 * time.Second
+
+// transportConfig controls how the client reaches the API: TLS trust and
+// client certificates, proxying and per-request timeouts.
+type transportConfig struct {
+	// CACertPEM and CACertFile add PEM CA certificates to the system pool.
+	CACertPEM  string
+	CACertFile string
+	// ClientCert and ClientKey enable mTLS. Each is PEM data or a path to a PEM file.
+	ClientCert string
+	ClientKey  string
+
+	InsecureSkipVerify bool
+	// ProxyURL overrides HTTP(S)_PROXY/NO_PROXY from the environment.
+	ProxyURL       string
+	RequestTimeout time.Duration
+}
+
+// newHTTPClient builds the *http.Client used for API requests.
+func newHTTPClient(cfg transportConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertPEM != "" || cfg.CACertFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if cfg.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, fmt.Errorf("ca_cert_pem does not contain any PEM certificates")
+		}
+		if cfg.CACertFile != "" {
+			data, err := os.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading ca_cert_file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(data) {
+				return nil, fmt.Errorf("ca_cert_file %s does not contain any PEM certificates", cfg.CACertFile)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (cfg.ClientCert == "") != (cfg.ClientKey == "") {
+		return nil, fmt.Errorf("both client_cert and client_key must be configured together")
+	}
+	if cfg.ClientCert != "" {
+		certPEM, err := pemOrFile(cfg.ClientCert, "client_cert")
+		if err != nil {
+			return nil, err
+		}
+		keyPEM, err := pemOrFile(cfg.ClientKey, "client_key")
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q: expected e.g. http://proxy.internal:3128", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	timeout := cfg.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
+}
+
+// pemOrFile returns v if it is PEM data, otherwise the contents of the file it names.
+func pemOrFile(v, name string) ([]byte, error) {
+	if strings.Contains(v, "-----BEGIN") {
+		return []byte(v), nil
+	}
+	data, err := os.ReadFile(v)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+	return data, nil
+}
+
+// parseHeaders parses "Name=value" pairs separated by commas, as accepted in
+// AUTOGLUE_HEADERS.
+func parseHeaders(raw string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, pair := range strings.Split(raw, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("expected Name=value pairs separated by commas, got %q", pair)
+		}
+		headers[name] = strings.TrimSpace(value)
+	}
+	return headers, nil
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTransportTestClient(t *testing.T, baseURL string, transport transportConfig, headers map[string]string) *autoglueClient {
+	t.Helper()
+	c, err := newAutoglueClient(clientConfig{
+		BaseURL:          baseURL,
+		APIKey:           "k",
+		OrgID:            "o",
+		RetryMaxAttempts: 1,
+		Transport:        transport,
+		Headers:          headers,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func certPEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+// newTestClientCert returns a self-signed client certificate and its key as PEM.
+func newTestClientCert(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, certPEM(cert), string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestTransport_CustomCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, map[string]string{"ok": "yes"})
+	}))
+	t.Cleanup(srv.Close)
+	ctx := context.Background()
+
+	c := newTransportTestClient(t, srv.URL, transportConfig{}, nil)
+	if err := c.doJSON(ctx, http.MethodGet, "/", "", nil, nil); err == nil || !strings.Contains(err.Error(), "certificate") {
+		t.Fatalf("expected an unknown authority error without a CA, got %v", err)
+	}
+
+	c = newTransportTestClient(t, srv.URL, transportConfig{CACertPEM: certPEM(srv.Certificate())}, nil)
+	if err := c.doJSON(ctx, http.MethodGet, "/", "", nil, nil); err != nil {
+		t.Fatalf("ca_cert_pem: %s", err)
+	}
+
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(file, []byte(certPEM(srv.Certificate())), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c = newTransportTestClient(t, srv.URL, transportConfig{CACertFile: file}, nil)
+	if err := c.doJSON(ctx, http.MethodGet, "/", "", nil, nil); err != nil {
+		t.Fatalf("ca_cert_file: %s", err)
+	}
+
+	c = newTransportTestClient(t, srv.URL, transportConfig{InsecureSkipVerify: true}, nil)
+	if err := c.doJSON(ctx, http.MethodGet, "/", "", nil, nil); err != nil {
+		t.Fatalf("insecure_skip_verify: %s", err)
+	}
+}
+
+func TestTransport_MutualTLS(t *testing.T) {
+	clientCert, clientCertPEM, clientKeyPEM := newTestClientCert(t)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			t.Errorf("client certificate not presented")
+		}
+		writePage(w, map[string]string{"ok": "yes"})
+	}))
+	pool := x509.NewCertPool()
+	pool.AddCert(clientCert)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	// Key given as a file, certificate inline.
+	keyFile := filepath.Join(t.TempDir(), "client.key")
+	if err := os.WriteFile(keyFile, []byte(clientKeyPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := newTransportTestClient(t, srv.URL, transportConfig{
+		CACertPEM:  certPEM(srv.Certificate()),
+		ClientCert: clientCertPEM,
+		ClientKey:  keyFile,
+	}, nil)
+	if err := c.doJSON(context.Background(), http.MethodGet, "/", "", nil, nil); err != nil {
+		t.Fatalf("mTLS request: %s", err)
+	}
+
+	_, err := newAutoglueClient(clientConfig{APIKey: "k", OrgID: "o", Transport: transportConfig{ClientCert: clientCertPEM}})
+	if err == nil || !strings.Contains(err.Error(), "client_cert and client_key") {
+		t.Errorf("expected an error for client_cert without client_key, got %v", err)
+	}
+}
+
+func TestTransport_ProxyHeadersAndTimeout(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy sees the absolute target URL.
+		proxied = r.URL.String()
+		if got := r.Header.Get("X-Tenant"); got != "blue" {
+			t.Errorf("X-Tenant = %q, want blue", got)
+		}
+		if got := r.Header.Get("X-API-KEY"); got != "k" {
+			t.Errorf("static headers overrode X-API-KEY: %q", got)
+		}
+		if got := r.Header.Get(correlationIDHeader); got == "overridden" || got == "" {
+			t.Errorf("static headers overrode %s: %q", correlationIDHeader, got)
+		}
+		if strings.HasSuffix(r.URL.Path, "/slow") {
+			time.Sleep(200 * time.Millisecond)
+		}
+		writePage(w, map[string]string{"ok": "yes"})
+	}))
+	t.Cleanup(proxy.Close)
+
+	c := newTransportTestClient(t, "http://autoglue.internal/api/v1", transportConfig{
+		ProxyURL:       proxy.URL,
+		RequestTimeout: 50 * time.Millisecond,
+	}, map[string]string{"X-Tenant": "blue", "X-API-KEY": "overridden", correlationIDHeader: "overridden"})
+
+	if err := c.doJSON(context.Background(), http.MethodGet, "/servers", "", nil, nil); err != nil {
+		t.Fatalf("proxied request: %s", err)
+	}
+	if proxied != "http://autoglue.internal/api/v1/servers" {
+		t.Errorf("proxy saw %q", proxied)
+	}
+
+	err := c.doJSON(context.Background(), http.MethodGet, "/slow", "", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "Client.Timeout") {
+		t.Errorf("expected request_timeout to abort the request, got %v", err)
+	}
+
+	if _, err := newHTTPClient(transportConfig{ProxyURL: "proxy.internal"}); err == nil {
+		t.Errorf("expected an error for a proxy_url without a scheme")
+	}
+}
+
+func TestProvider_TransportEnv(t *testing.T) {
+	api := newFakeAPI(t)
+	t.Setenv("AUTOGLUE_HEADERS", "X-Tenant=blue, X-Trace = 1")
+	t.Setenv("AUTOGLUE_REQUEST_TIMEOUT", "5s")
+	h := newTestHarness(t, api)
+	h.ReadDataSource("autoglue_ssh_keys", map[string]any{})
+
+	t.Setenv("AUTOGLUE_INSECURE_SKIP_VERIFY", "sometimes")
+	newUnconfiguredHarness(t).ConfigureExpectError(map[string]any{
+		"base_url": api.URL(),
+		"api_key":  api.apiKey,
+		"org_id":   api.orgID,
+	}, "AUTOGLUE_INSECURE_SKIP_VERIFY must be a boolean")
+
+	headers, err := parseHeaders("X-Tenant=blue, X-Trace = 1")
+	if err != nil || headers["X-Tenant"] != "blue" || headers["X-Trace"] != "1" {
+		t.Errorf("parseHeaders = %v, %v", headers, err)
+	}
+	if _, err := parseHeaders("X-Tenant"); err == nil {
+		t.Errorf("expected an error for a header without a value")
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The API only checks an attachment's target when it is attached, so a wrong
+// ID fails halfway through an apply. The helpers below resolve the target at
+// plan time instead. A target that can't be resolved for any reason other
+// than not existing is left for the API to judge.
+
+// attachmentCheckContext returns ctx scoped to the org_id planned for an
+// attachment resource, so targets are looked up in the right organization.
+func (c *autoglueClient) attachmentCheckContext(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) context.Context {
+	var orgID types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("org_id"), &orgID)...)
+	if orgID.IsUnknown() {
+		orgID = types.StringNull()
+	}
+	return c.scopeToOrg(ctx, &orgID, diags)
+}
+
+// changedAttachmentID returns the ID configured at attr if it is known and
+// differs from state, so unchanged attachments are not looked up again on
+// every plan. It returns "" otherwise, including on destroy.
+func changedAttachmentID(ctx context.Context, req resource.ModifyPlanRequest, attr path.Path, diags *diag.Diagnostics) string {
+	if req.Plan.Raw.IsNull() {
+		return ""
+	}
+	var configured, prior types.String
+	diags.Append(req.Config.GetAttribute(ctx, attr, &configured)...)
+	if diags.HasError() || configured.IsNull() || configured.IsUnknown() {
+		return ""
+	}
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, attr, &prior)...)
+		if configured.Equal(prior) {
+			return ""
+		}
+	}
+	return configured.ValueString()
+}
+
+// lookupAttachmentTarget GETs p into out. It reports whether out was filled,
+// adding an error on attr if the target doesn't exist.
+func (c *autoglueClient) lookupAttachmentTarget(ctx context.Context, attr path.Path, what, id, p string, out any, diags *diag.Diagnostics) bool {
+	err := c.doJSON(ctx, http.MethodGet, p, "", nil, out)
+	if err == nil {
+		return true
+	}
+	if isNotFound(err) {
+		diags.AddAttributeError(attr, fmt.Sprintf("Unknown %s", what),
+			fmt.Sprintf("No %s with ID %s exists in this organization.", what, id))
+		return false
+	}
+	tflog.Warn(ctx, "Unable to check cluster attachment target at plan time", map[string]any{
+		"target": what,
+		"id":     id,
+		"error":  err.Error(),
+	})
+	return false
+}
+
+// checkAttachmentLoadBalancer requires load balancer id to be of kind
+// wantKind. A load balancer without a kind predates kinds and is accepted.
+func (c *autoglueClient) checkAttachmentLoadBalancer(ctx context.Context, attr path.Path, id, wantKind, attachment string, diags *diag.Diagnostics) {
+	var lb loadBalancer
+	if !c.lookupAttachmentTarget(ctx, attr, "load balancer", id, fmt.Sprintf("/load-balancers/%s", id), &lb, diags) {
+		return
+	}
+	if lb.Kind != "" && lb.Kind != wantKind {
+		diags.AddAttributeError(attr, "Wrong load balancer kind",
+			fmt.Sprintf("load balancer %s (%s) is kind=%s but %s requires kind=%s.", id, lb.Name, lb.Kind, attachment, wantKind))
+	}
+}
+
+// checkAttachmentBastion requires server id to have the bastion role.
+func (c *autoglueClient) checkAttachmentBastion(ctx context.Context, attr path.Path, id, attachment string, diags *diag.Diagnostics) {
+	var s server
+	if !c.lookupAttachmentTarget(ctx, attr, "server", id, fmt.Sprintf("/servers/%s", id), &s, diags) {
+		return
+	}
+	if s.Role != "bastion" {
+		diags.AddAttributeError(attr, "Server is not a bastion",
+			fmt.Sprintf("server %s (%s) has role=%q but %s requires role=bastion.", id, s.Hostname, s.Role, attachment))
+	}
+}
+
+// checkAttachmentDomain requires domain id to exist.
+func (c *autoglueClient) checkAttachmentDomain(ctx context.Context, attr path.Path, id string, diags *diag.Diagnostics) {
+	var d domain
+	c.lookupAttachmentTarget(ctx, attr, "domain", id, fmt.Sprintf("/dns/domains/%s", id), &d, diags)
+}
+
+// checkAttachmentRecordSet requires record set id to exist and, if
+// captainDomainID is set, to belong to that domain.
+func (c *autoglueClient) checkAttachmentRecordSet(ctx context.Context, attr path.Path, id, captainDomainID string, diags *diag.Diagnostics) {
+	var rs recordSet
+	if !c.lookupAttachmentTarget(ctx, attr, "record set", id, fmt.Sprintf("/dns/records/%s", id), &rs, diags) {
+		return
+	}
+	if captainDomainID != "" && rs.DomainID != captainDomainID {
+		diags.AddAttributeError(attr, "Record set not in captain domain",
+			fmt.Sprintf("record set %s domain_id %s does not match captain domain %s. "+
+				"The control plane record set must be a record in the cluster's captain domain.", id, rs.DomainID, captainDomainID))
+	}
+}
+
+// clusterCaptainDomainID returns the ID of the captain domain attached to
+// cluster clusterID, or "" if there is none or it can't be read.
+func (c *autoglueClient) clusterCaptainDomainID(ctx context.Context, clusterID string) string {
+	var apiResp cluster
+	if err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/clusters/%s", clusterID), "", nil, &apiResp); err != nil {
+		tflog.Warn(ctx, "Unable to read cluster captain domain at plan time", map[string]any{
+			"cluster_id": clusterID,
+			"error":      err.Error(),
+		})
+		return ""
+	}
+	if apiResp.CaptainDomain == nil {
+		return ""
+	}
+	return apiResp.CaptainDomain.ID
+}
@@ -0,0 +1,633 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &clusterAttachmentsResource{}
+	_ resource.ResourceWithConfigure   = &clusterAttachmentsResource{}
+	_ resource.ResourceWithImportState = &clusterAttachmentsResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterAttachmentsResource{}
+)
+
+type clusterAttachmentsResource struct {
+	client *autoglueClient
+}
+
+type clusterAttachmentsModel struct {
+	ID        types.String `tfsdk:"id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+
+	CaptainDomain         *attachedDomainModel       `tfsdk:"captain_domain"`
+	ControlPlaneRecordSet *attachedRecordSetModel    `tfsdk:"control_plane_record_set"`
+	AppsLoadBalancer      *attachedLoadBalancerModel `tfsdk:"apps_load_balancer"`
+	GlueOpsLoadBalancer   *attachedLoadBalancerModel `tfsdk:"glueops_load_balancer"`
+	Bastion               *attachedBastionModel      `tfsdk:"bastion"`
+	NodePools             *attachedNodePoolsModel    `tfsdk:"node_pools"`
+	Kubeconfig            *attachedKubeconfigModel   `tfsdk:"kubeconfig"`
+
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+type attachedDomainModel struct {
+	DomainID types.String `tfsdk:"domain_id"`
+}
+
+type attachedRecordSetModel struct {
+	RecordSetID types.String `tfsdk:"record_set_id"`
+}
+
+type attachedLoadBalancerModel struct {
+	LoadBalancerID types.String `tfsdk:"load_balancer_id"`
+}
+
+type attachedBastionModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+}
+
+type attachedNodePoolsModel struct {
+	NodePoolIDs types.Set `tfsdk:"node_pool_ids"`
+}
+
+type attachedKubeconfigModel struct {
+	Kubeconfig        types.String `tfsdk:"kubeconfig"`
+	KubeconfigVersion types.Int64  `tfsdk:"kubeconfig_version"`
+}
+
+// singleAttachment describes one of the cluster's single-valued attachments:
+// set with POST /clusters/{id}/{path} and cleared with DELETE on the same path.
+type singleAttachment struct {
+	field string
+	path  string
+	label string
+	// get returns the attached ID in m, or "" if the block is absent.
+	get func(m *clusterAttachmentsModel) string
+	// set stores id in m, removing the block when id is "".
+	set func(m *clusterAttachmentsModel, id string)
+	// current returns the ID attached according to the API.
+	current func(c *cluster) string
+}
+
+// singleAttachments are in attach order: the record set is resolved against
+// the captain domain, so the domain comes first. Detaches run in reverse.
+var singleAttachments = []singleAttachment{
+	{
+		field: "domain_id", path: "captain-domain", label: "captain domain",
+		get: func(m *clusterAttachmentsModel) string {
+			if m.CaptainDomain == nil {
+				return ""
+			}
+			return m.CaptainDomain.DomainID.ValueString()
+		},
+		set: func(m *clusterAttachmentsModel, id string) {
+			m.CaptainDomain = nil
+			if id != "" {
+				m.CaptainDomain = &attachedDomainModel{DomainID: types.StringValue(id)}
+			}
+		},
+		current: func(c *cluster) string {
+			if c.CaptainDomain == nil {
+				return ""
+			}
+			return c.CaptainDomain.ID
+		},
+	},
+	{
+		field: "record_set_id", path: "control-plane-record-set", label: "control plane record set",
+		get: func(m *clusterAttachmentsModel) string {
+			if m.ControlPlaneRecordSet == nil {
+				return ""
+			}
+			return m.ControlPlaneRecordSet.RecordSetID.ValueString()
+		},
+		set: func(m *clusterAttachmentsModel, id string) {
+			m.ControlPlaneRecordSet = nil
+			if id != "" {
+				m.ControlPlaneRecordSet = &attachedRecordSetModel{RecordSetID: types.StringValue(id)}
+			}
+		},
+		current: func(c *cluster) string {
+			if c.ControlPlaneRecordSet == nil {
+				return ""
+			}
+			return c.ControlPlaneRecordSet.ID
+		},
+	},
+	{
+		field: "load_balancer_id", path: "glueops-load-balancer", label: "GlueOps load balancer",
+		get: func(m *clusterAttachmentsModel) string {
+			if m.GlueOpsLoadBalancer == nil {
+				return ""
+			}
+			return m.GlueOpsLoadBalancer.LoadBalancerID.ValueString()
+		},
+		set: func(m *clusterAttachmentsModel, id string) {
+			m.GlueOpsLoadBalancer = nil
+			if id != "" {
+				m.GlueOpsLoadBalancer = &attachedLoadBalancerModel{LoadBalancerID: types.StringValue(id)}
+			}
+		},
+		current: func(c *cluster) string {
+			if c.GlueOpsLoadBalancer == nil {
+				return ""
+			}
+			return c.GlueOpsLoadBalancer.ID
+		},
+	},
+	{
+		field: "load_balancer_id", path: "apps-load-balancer", label: "apps load balancer",
+		get: func(m *clusterAttachmentsModel) string {
+			if m.AppsLoadBalancer == nil {
+				return ""
+			}
+			return m.AppsLoadBalancer.LoadBalancerID.ValueString()
+		},
+		set: func(m *clusterAttachmentsModel, id string) {
+			m.AppsLoadBalancer = nil
+			if id != "" {
+				m.AppsLoadBalancer = &attachedLoadBalancerModel{LoadBalancerID: types.StringValue(id)}
+			}
+		},
+		current: func(c *cluster) string {
+			if c.AppsLoadBalancer == nil {
+				return ""
+			}
+			return c.AppsLoadBalancer.ID
+		},
+	},
+	{
+		field: "server_id", path: "bastion", label: "bastion server",
+		get: func(m *clusterAttachmentsModel) string {
+			if m.Bastion == nil {
+				return ""
+			}
+			return m.Bastion.ServerID.ValueString()
+		},
+		set: func(m *clusterAttachmentsModel, id string) {
+			m.Bastion = nil
+			if id != "" {
+				m.Bastion = &attachedBastionModel{ServerID: types.StringValue(id)}
+			}
+		},
+		current: func(c *cluster) string {
+			if c.BastionServer == nil {
+				return ""
+			}
+			return c.BastionServer.ID
+		},
+	},
+}
+
+// clusterAttachmentCall is one API request needed to move a cluster from one
+// attachment set to another.
+type clusterAttachmentCall struct {
+	method  string
+	path    string
+	payload any
+	message string
+	fields  map[string]any
+}
+
+func NewClusterAttachmentsResource() resource.Resource {
+	return &clusterAttachmentsResource{}
+}
+
+func (r *clusterAttachmentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_attachments"
+}
+
+func (r *clusterAttachmentsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Attributes of an absent single nested block are still validated, so
+	// the block requires them instead of marking them Required.
+	requires := func(attr string) []validator.Object {
+		return []validator.Object{objectvalidator.AlsoRequires(path.MatchRelative().AtName(attr))}
+	}
+	idBlock := func(description, attr, attrDescription string) resourceschema.SingleNestedBlock {
+		return resourceschema.SingleNestedBlock{
+			Description: description,
+			Validators:  requires(attr),
+			Attributes: map[string]resourceschema.Attribute{
+				attr: resourceschema.StringAttribute{
+					Optional:    true,
+					Description: attrDescription + " Required if the block is present.",
+				},
+			},
+		}
+	}
+	// The control plane record set must be a record in the captain domain.
+	recordSetBlock := idBlock("Record set for the cluster's control plane endpoint. Must be in the captain domain, so requires `captain_domain`.", "record_set_id", "Record set ID.")
+	recordSetBlock.Validators = append(recordSetBlock.Validators, objectvalidator.AlsoRequires(path.MatchRoot("captain_domain")))
+
+	resp.Schema = resourceschema.Schema{
+		Description: "Manages everything attached to a cluster in one resource: captain domain, control plane record set, " +
+			"load balancers, bastion, node pools and kubeconfig. Only the attachments that changed are sent to the API, " +
+			"in dependency order (e.g. the captain domain before the control plane record set), and a removed block detaches its attachment. " +
+			"Import with the cluster ID to adopt all of its current attachments. " +
+			"Don't also manage the same cluster's attachments with the single-purpose `autoglue_cluster_*` attachment resources.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Synthetic ID, equal to cluster_id.",
+			},
+			"cluster_id": resourceschema.StringAttribute{
+				Required:    true,
+				Description: "Cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+		Blocks: map[string]resourceschema.Block{
+			"captain_domain":           idBlock("Captain domain of the cluster.", "domain_id", "Domain ID."),
+			"control_plane_record_set": recordSetBlock,
+			"apps_load_balancer":       idBlock("Load balancer for application traffic.", "load_balancer_id", "Load balancer ID."),
+			"glueops_load_balancer":    idBlock("Load balancer for GlueOps platform traffic.", "load_balancer_id", "Load balancer ID."),
+			"bastion":                  idBlock("Bastion server of the cluster.", "server_id", "Server ID."),
+			"node_pools": resourceschema.SingleNestedBlock{
+				Description: "Node pools attached to the cluster.",
+				Validators:  requires("node_pool_ids"),
+				Attributes: map[string]resourceschema.Attribute{
+					"node_pool_ids": resourceschema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Set of node pool IDs. Required if the block is present.",
+					},
+				},
+			},
+			"kubeconfig": resourceschema.SingleNestedBlock{
+				Description: "Kubeconfig of the cluster. It is encrypted server-side and never read back, " +
+					"so an imported resource sends it again on the next apply.",
+				Validators: requires("kubeconfig"),
+				Attributes: map[string]resourceschema.Attribute{
+					"kubeconfig": resourceschema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+						WriteOnly: true,
+						Description: "Kubeconfig YAML for the cluster. Required if the block is present. Write-only (requires Terraform 1.11+): " +
+							"sent when the block is added and whenever `kubeconfig_version` changes, never stored in plan or state.",
+					},
+					"kubeconfig_version": resourceschema.Int64Attribute{
+						Optional: true,
+						Description: "Arbitrary version number for `kubeconfig`. Terraform can't detect changes to a write-only value, " +
+							"so change this (e.g. increment it) to send an updated `kubeconfig` to the API.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *clusterAttachmentsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan resolves changed attachment targets and fails the plan if one
+// doesn't exist or can't be attached: a load balancer of the wrong kind, a
+// server that isn't a bastion, or a record set outside the captain domain.
+func (r *clusterAttachmentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	ctx = r.client.attachmentCheckContext(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainAttr := path.Root("captain_domain").AtName("domain_id")
+	domainID := changedAttachmentID(ctx, req, domainAttr, &resp.Diagnostics)
+	if domainID != "" {
+		r.client.checkAttachmentDomain(ctx, domainAttr, domainID, &resp.Diagnostics)
+	}
+
+	// A record set is checked again when the captain domain changes under it.
+	recordSetAttr := path.Root("control_plane_record_set").AtName("record_set_id")
+	if changedAttachmentID(ctx, req, recordSetAttr, &resp.Diagnostics) != "" || domainID != "" {
+		var recordSetID, captainDomainID types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, recordSetAttr, &recordSetID)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, domainAttr, &captainDomainID)...)
+		if !recordSetID.IsNull() && !recordSetID.IsUnknown() {
+			r.client.checkAttachmentRecordSet(ctx, recordSetAttr, recordSetID.ValueString(), captainDomainID.ValueString(), &resp.Diagnostics)
+		}
+	}
+
+	for _, lb := range []struct{ block, kind string }{
+		{"apps_load_balancer", "public"},
+		{"glueops_load_balancer", "glueops"},
+	} {
+		attr := path.Root(lb.block).AtName("load_balancer_id")
+		if id := changedAttachmentID(ctx, req, attr, &resp.Diagnostics); id != "" {
+			r.client.checkAttachmentLoadBalancer(ctx, attr, id, lb.kind, lb.block, &resp.Diagnostics)
+		}
+	}
+
+	bastionAttr := path.Root("bastion").AtName("server_id")
+	if id := changedAttachmentID(ctx, req, bastionAttr, &resp.Diagnostics); id != "" {
+		r.client.checkAttachmentBastion(ctx, bastionAttr, id, "bastion", &resp.Diagnostics)
+	}
+}
+
+func (r *clusterAttachmentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan, config clusterAttachmentsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := plan.ClusterID.ValueString()
+	if clusterID == "" {
+		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set.")
+		return
+	}
+	plan.ID = types.StringValue(clusterID)
+
+	prior := clusterAttachmentsModel{ID: plan.ID, ClusterID: plan.ClusterID, OrgID: plan.OrgID}
+	r.sync(ctx, &prior, &plan, &config, &resp.State, &resp.Diagnostics)
+}
+
+func (r *clusterAttachmentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state clusterAttachmentsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.ClusterID.ValueString()
+	if clusterID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := r.readIntoModel(ctx, clusterID, &state, &resp.Diagnostics); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading cluster attachments", fmt.Sprintf("Error reading cluster attachments: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *clusterAttachmentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan, config, state clusterAttachmentsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.ClusterID.ValueString()
+	if clusterID == "" {
+		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
+		return
+	}
+	plan.ID = types.StringValue(clusterID)
+
+	r.sync(ctx, &state, &plan, &config, &resp.State, &resp.Diagnostics)
+}
+
+func (r *clusterAttachmentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state clusterAttachmentsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ClusterID.ValueString() == "" {
+		return
+	}
+
+	empty := clusterAttachmentsModel{ID: state.ID, ClusterID: state.ClusterID, OrgID: state.OrgID}
+	if r.sync(ctx, &state, &empty, &empty, &resp.State, &resp.Diagnostics) {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r *clusterAttachmentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// terraform import autoglue_cluster_attachments.example <cluster_id> or <org_id>/<cluster_id>
+	// Read then adopts every attachment the cluster currently has.
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
+}
+
+// sync sends the calls that turn prior into plan and saves plan to state. If
+// a call fails, the attachments the API reports afterwards are saved
+// instead, so the next plan only retries what is left.
+func (r *clusterAttachmentsResource) sync(
+	ctx context.Context,
+	prior, plan, config *clusterAttachmentsModel,
+	state *tfsdk.State,
+	diags *diag.Diagnostics,
+) bool {
+	clusterID := plan.ClusterID.ValueString()
+	calls := planClusterAttachmentCalls(ctx, clusterID, prior, plan, config, diags)
+	if diags.HasError() {
+		return false
+	}
+
+	for _, call := range calls {
+		tflog.Info(ctx, call.message, call.fields)
+		if err := r.client.doJSON(ctx, call.method, call.path, "", call.payload, nil); err != nil {
+			diags.AddError("Error updating cluster attachments", fmt.Sprintf("%s: %s", call.message, err.Error()))
+
+			partial := *prior
+			if rerr := r.readIntoModel(ctx, clusterID, &partial, diags); rerr == nil {
+				diags.Append(state.Set(ctx, &partial)...)
+			}
+			return false
+		}
+	}
+
+	diags.Append(state.Set(ctx, plan)...)
+	return !diags.HasError()
+}
+
+// planClusterAttachmentCalls returns the minimal, ordered list of calls that
+// turn the attachments in from into those in to: detaches first, last
+// dependency first, then attaches in dependency order. A changed
+// single-valued attachment is replaced with one POST.
+func planClusterAttachmentCalls(ctx context.Context, clusterID string, from, to, config *clusterAttachmentsModel, diags *diag.Diagnostics) []clusterAttachmentCall {
+	var detach, attach []clusterAttachmentCall
+
+	if from.Kubeconfig != nil && to.Kubeconfig == nil {
+		detach = append(detach, clusterAttachmentCall{
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/clusters/%s/kubeconfig", clusterID),
+			message: "Clearing cluster kubeconfig",
+			fields:  map[string]any{"cluster_id": clusterID},
+		})
+	}
+
+	var oldPools, newPools []string
+	if from.NodePools != nil {
+		oldPools = stringSetToSlice(ctx, from.NodePools.NodePoolIDs, diags)
+	}
+	if to.NodePools != nil {
+		newPools = stringSetToSlice(ctx, to.NodePools.NodePoolIDs, diags)
+	}
+	if diags.HasError() {
+		return nil
+	}
+	removedPools, addedPools := sliceDifference(oldPools, newPools)
+	slices.Sort(removedPools)
+	slices.Sort(addedPools)
+	for _, npID := range removedPools {
+		detach = append(detach, clusterAttachmentCall{
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/clusters/%s/node-pools/%s", clusterID, npID),
+			message: "Detaching node pool from cluster",
+			fields:  map[string]any{"cluster_id": clusterID, "node_pool_id": npID},
+		})
+	}
+
+	for i := len(singleAttachments) - 1; i >= 0; i-- {
+		a := singleAttachments[i]
+		if a.get(from) != "" && a.get(to) == "" {
+			detach = append(detach, clusterAttachmentCall{
+				method:  http.MethodDelete,
+				path:    fmt.Sprintf("/clusters/%s/%s", clusterID, a.path),
+				message: "Detaching " + a.label + " from cluster",
+				fields:  map[string]any{"cluster_id": clusterID},
+			})
+		}
+	}
+
+	for _, a := range singleAttachments {
+		id := a.get(to)
+		if id != "" && id != a.get(from) {
+			attach = append(attach, clusterAttachmentCall{
+				method:  http.MethodPost,
+				path:    fmt.Sprintf("/clusters/%s/%s", clusterID, a.path),
+				payload: map[string]string{a.field: id},
+				message: "Attaching " + a.label + " to cluster",
+				fields:  map[string]any{"cluster_id": clusterID, a.field: id},
+			})
+		}
+	}
+
+	for _, npID := range addedPools {
+		attach = append(attach, clusterAttachmentCall{
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/clusters/%s/node-pools", clusterID),
+			payload: attachNodePoolPayload{NodePoolID: npID},
+			message: "Attaching node pool to cluster",
+			fields:  map[string]any{"cluster_id": clusterID, "node_pool_id": npID},
+		})
+	}
+
+	if to.Kubeconfig != nil && (from.Kubeconfig == nil || !to.Kubeconfig.KubeconfigVersion.Equal(from.Kubeconfig.KubeconfigVersion)) {
+		// kubeconfig is write-only, so it's only available from config.
+		var kubeconfig string
+		if config.Kubeconfig != nil {
+			kubeconfig = config.Kubeconfig.Kubeconfig.ValueString()
+		}
+		attach = append(attach, clusterAttachmentCall{
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/clusters/%s/kubeconfig", clusterID),
+			payload: setKubeconfigPayload{Kubeconfig: kubeconfig},
+			message: "Setting cluster kubeconfig",
+			fields:  map[string]any{"cluster_id": clusterID},
+		})
+	}
+
+	return append(detach, attach...)
+}
+
+// readIntoModel updates model from a single GET /clusters/{id}. Every
+// attachment the API reports is adopted, so importing a cluster ID brings in
+// its whole attachment set. The kubeconfig block is kept as is, since the
+// API never returns it.
+func (r *clusterAttachmentsResource) readIntoModel(ctx context.Context, clusterID string, model *clusterAttachmentsModel, diags *diag.Diagnostics) error {
+	var apiResp cluster
+	if err := r.client.doJSON(ctx, http.MethodGet, fmt.Sprintf("/clusters/%s", clusterID), "", nil, &apiResp); err != nil {
+		return err
+	}
+
+	for _, a := range singleAttachments {
+		a.set(model, a.current(&apiResp))
+	}
+
+	ids := make([]string, 0, len(apiResp.NodePools))
+	for _, np := range apiResp.NodePools {
+		ids = append(ids, np.ID)
+	}
+	if model.NodePools != nil || len(ids) > 0 {
+		setVal, d := types.SetValueFrom(ctx, types.StringType, ids)
+		diags.Append(d...)
+		model.NodePools = &attachedNodePoolsModel{NodePoolIDs: setVal}
+	}
+
+	model.ClusterID = types.StringValue(clusterID)
+	model.ID = types.StringValue(clusterID)
+	return nil
+}
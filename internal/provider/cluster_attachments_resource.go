@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -24,6 +26,7 @@ var (
 	_ resource.Resource                = &clusterCaptainDomainResource{}
 	_ resource.ResourceWithConfigure   = &clusterCaptainDomainResource{}
 	_ resource.ResourceWithImportState = &clusterCaptainDomainResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterCaptainDomainResource{}
 )
 
 type clusterCaptainDomainResource struct {
@@ -34,6 +37,8 @@ type clusterCaptainDomainModel struct {
 	ID        types.String `tfsdk:"id"`
 	ClusterID types.String `tfsdk:"cluster_id"`
 	DomainID  types.String `tfsdk:"domain_id"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewClusterCaptainDomainResource() resource.Resource {
@@ -63,6 +68,7 @@ func (r *clusterCaptainDomainResource) Schema(_ context.Context, _ resource.Sche
 				Required:    true,
 				Description: "Domain ID to attach as captain domain.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -82,6 +88,23 @@ func (r *clusterCaptainDomainResource) Configure(_ context.Context, req resource
 	r.client = client
 }
 
+// ModifyPlan checks that the domain exists before it is attached.
+func (r *clusterCaptainDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	attr := path.Root("domain_id")
+	id := changedAttachmentID(ctx, req, attr, &resp.Diagnostics)
+	if id == "" {
+		return
+	}
+	ctx = r.client.attachmentCheckContext(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client.checkAttachmentDomain(ctx, attr, id, &resp.Diagnostics)
+}
+
 func (r *clusterCaptainDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
@@ -95,6 +118,11 @@ func (r *clusterCaptainDomainResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := plan.ClusterID.ValueString()
 	domainID := plan.DomainID.ValueString()
 	if clusterID == "" || domainID == "" {
@@ -135,6 +163,11 @@ func (r *clusterCaptainDomainResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.State.RemoveResource(ctx)
@@ -186,6 +219,11 @@ func (r *clusterCaptainDomainResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
@@ -228,6 +266,11 @@ func (r *clusterCaptainDomainResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		return
@@ -248,8 +291,8 @@ func (r *clusterCaptainDomainResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *clusterCaptainDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_captain_domain.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	// terraform import autoglue_cluster_captain_domain.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
 }
 
 type attachRecordSetPayload struct {
@@ -260,6 +303,7 @@ var (
 	_ resource.Resource                = &clusterControlPlaneRecordSetResource{}
 	_ resource.ResourceWithConfigure   = &clusterControlPlaneRecordSetResource{}
 	_ resource.ResourceWithImportState = &clusterControlPlaneRecordSetResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterControlPlaneRecordSetResource{}
 )
 
 type clusterControlPlaneRecordSetResource struct {
@@ -270,6 +314,8 @@ type clusterControlPlaneRecordSetModel struct {
 	ID          types.String `tfsdk:"id"`
 	ClusterID   types.String `tfsdk:"cluster_id"`
 	RecordSetID types.String `tfsdk:"record_set_id"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewClusterControlPlaneRecordSetResource() resource.Resource {
@@ -299,6 +345,7 @@ func (r *clusterControlPlaneRecordSetResource) Schema(_ context.Context, _ resou
 				Required:    true,
 				Description: "Record set ID to attach as control-plane record set.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -318,6 +365,32 @@ func (r *clusterControlPlaneRecordSetResource) Configure(_ context.Context, req
 	r.client = client
 }
 
+// ModifyPlan checks that the record set exists and belongs to the cluster's
+// captain domain. A cluster without a captain domain yet may be getting one
+// in the same apply, so only a mismatch with an attached one is an error.
+func (r *clusterControlPlaneRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	attr := path.Root("record_set_id")
+	id := changedAttachmentID(ctx, req, attr, &resp.Diagnostics)
+	if id == "" {
+		return
+	}
+	ctx = r.client.attachmentCheckContext(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var clusterID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cluster_id"), &clusterID)...)
+	var captainDomainID string
+	if !clusterID.IsNull() && !clusterID.IsUnknown() {
+		captainDomainID = r.client.clusterCaptainDomainID(ctx, clusterID.ValueString())
+	}
+	r.client.checkAttachmentRecordSet(ctx, attr, id, captainDomainID, &resp.Diagnostics)
+}
+
 func (r *clusterControlPlaneRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
@@ -331,6 +404,11 @@ func (r *clusterControlPlaneRecordSetResource) Create(ctx context.Context, req r
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := plan.ClusterID.ValueString()
 	rsID := plan.RecordSetID.ValueString()
 	if clusterID == "" || rsID == "" {
@@ -370,6 +448,11 @@ func (r *clusterControlPlaneRecordSetResource) Read(ctx context.Context, req res
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.State.RemoveResource(ctx)
@@ -419,6 +502,11 @@ func (r *clusterControlPlaneRecordSetResource) Update(ctx context.Context, req r
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
@@ -461,6 +549,11 @@ func (r *clusterControlPlaneRecordSetResource) Delete(ctx context.Context, req r
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		return
@@ -481,8 +574,8 @@ func (r *clusterControlPlaneRecordSetResource) Delete(ctx context.Context, req r
 }
 
 func (r *clusterControlPlaneRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_control_plane_record_set.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	// terraform import autoglue_cluster_control_plane_record_set.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
 }
 
 type attachLoadBalancerPayload struct {
@@ -493,6 +586,7 @@ var (
 	_ resource.Resource                = &clusterAppsLoadBalancerResource{}
 	_ resource.ResourceWithConfigure   = &clusterAppsLoadBalancerResource{}
 	_ resource.ResourceWithImportState = &clusterAppsLoadBalancerResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterAppsLoadBalancerResource{}
 )
 
 type clusterAppsLoadBalancerResource struct {
@@ -503,6 +597,8 @@ type clusterAppsLoadBalancerModel struct {
 	ID             types.String `tfsdk:"id"`
 	ClusterID      types.String `tfsdk:"cluster_id"`
 	LoadBalancerID types.String `tfsdk:"load_balancer_id"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewClusterAppsLoadBalancerResource() resource.Resource {
@@ -532,6 +628,7 @@ func (r *clusterAppsLoadBalancerResource) Schema(_ context.Context, _ resource.S
 				Required:    true,
 				Description: "Load balancer ID to attach as apps LB.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -551,6 +648,23 @@ func (r *clusterAppsLoadBalancerResource) Configure(_ context.Context, req resou
 	r.client = client
 }
 
+// ModifyPlan checks that the load balancer exists and is a public one.
+func (r *clusterAppsLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	attr := path.Root("load_balancer_id")
+	id := changedAttachmentID(ctx, req, attr, &resp.Diagnostics)
+	if id == "" {
+		return
+	}
+	ctx = r.client.attachmentCheckContext(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client.checkAttachmentLoadBalancer(ctx, attr, id, "public", "autoglue_cluster_apps_load_balancer", &resp.Diagnostics)
+}
+
 func (r *clusterAppsLoadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
@@ -564,6 +678,11 @@ func (r *clusterAppsLoadBalancerResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := plan.ClusterID.ValueString()
 	lbID := plan.LoadBalancerID.ValueString()
 	if clusterID == "" || lbID == "" {
@@ -603,6 +722,11 @@ func (r *clusterAppsLoadBalancerResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.State.RemoveResource(ctx)
@@ -652,6 +776,11 @@ func (r *clusterAppsLoadBalancerResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
@@ -694,6 +823,11 @@ func (r *clusterAppsLoadBalancerResource) Delete(ctx context.Context, req resour
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		return
@@ -714,8 +848,8 @@ func (r *clusterAppsLoadBalancerResource) Delete(ctx context.Context, req resour
 }
 
 func (r *clusterAppsLoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_apps_load_balancer.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	// terraform import autoglue_cluster_apps_load_balancer.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
 }
 
 type attachNodePoolPayload struct {
@@ -723,9 +857,10 @@ type attachNodePoolPayload struct {
 }
 
 var (
-	_ resource.Resource                = &clusterNodePoolsResource{}
-	_ resource.ResourceWithConfigure   = &clusterNodePoolsResource{}
-	_ resource.ResourceWithImportState = &clusterNodePoolsResource{}
+	_ resource.Resource                 = &clusterNodePoolsResource{}
+	_ resource.ResourceWithConfigure    = &clusterNodePoolsResource{}
+	_ resource.ResourceWithImportState  = &clusterNodePoolsResource{}
+	_ resource.ResourceWithUpgradeState = &clusterNodePoolsResource{}
 )
 
 type clusterNodePoolsResource struct {
@@ -733,9 +868,19 @@ type clusterNodePoolsResource struct {
 }
 
 type clusterNodePoolsModel struct {
-	ID          types.String `tfsdk:"id"`
-	ClusterID   types.String `tfsdk:"cluster_id"`
-	NodePoolIDs types.Set    `tfsdk:"node_pool_ids"`
+	ID                   types.String `tfsdk:"id"`
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	NodePoolIDs          types.Set    `tfsdk:"node_pool_ids"`
+	Exclusive            types.Bool   `tfsdk:"exclusive"`
+	UnmanagedNodePoolIDs types.Set    `tfsdk:"unmanaged_node_pool_ids"`
+
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+// exclusive reports whether the resource owns the cluster's full set of node
+// pools. It's null in imported state until the first plan sets the default.
+func (m *clusterNodePoolsModel) exclusive() bool {
+	return m.Exclusive.IsNull() || m.Exclusive.IsUnknown() || m.Exclusive.ValueBool()
 }
 
 func NewClusterNodePoolsResource() resource.Resource {
@@ -749,6 +894,7 @@ func (r *clusterNodePoolsResource) Metadata(_ context.Context, req resource.Meta
 func (r *clusterNodePoolsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resourceschema.Schema{
 		Description: "Manages the set of node pools attached to a cluster.",
+		Version:     1,
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.StringAttribute{
 				Computed:    true,
@@ -769,6 +915,21 @@ func (r *clusterNodePoolsResource) Schema(_ context.Context, _ resource.SchemaRe
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"exclusive": resourceschema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "If true (the default), this resource owns the cluster's full set of node pools: " +
+					"pools attached any other way show up as drift and are detached on the next apply. " +
+					"If false, only the pools in `node_pool_ids` are managed; others are left attached and reported in `unmanaged_node_pool_ids`.",
+			},
+			"unmanaged_node_pool_ids": resourceschema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Node pools attached to the cluster that this resource doesn't manage, e.g. by another stack or out-of-band. " +
+					"Always empty when `exclusive` is true.",
+			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -801,6 +962,11 @@ func (r *clusterNodePoolsResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := plan.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set.")
@@ -812,19 +978,19 @@ func (r *clusterNodePoolsResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	for _, npID := range nodePoolIDs {
-		path := fmt.Sprintf("/clusters/%s/node-pools", clusterID)
-		payload := attachNodePoolPayload{NodePoolID: npID}
-
-		tflog.Info(ctx, "Attaching node pool to cluster", map[string]any{
-			"cluster_id":   clusterID,
-			"node_pool_id": npID,
-		})
-
-		if err := r.client.doJSON(ctx, http.MethodPost, path, "", payload, nil); err != nil {
-			resp.Diagnostics.AddError("Error attaching node pool to cluster", err.Error())
-			return
-		}
+	// Only pools that aren't attached yet are sent; an exclusive resource
+	// also detaches whatever else is attached.
+	attached, err := r.attachedNodePoolIDs(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cluster node pools", err.Error())
+		return
+	}
+	toDetach, toAttach := sliceDifference(attached, nodePoolIDs)
+	if !plan.exclusive() {
+		toDetach = nil
+	}
+	if !r.syncNodePools(ctx, clusterID, toAttach, toDetach, &resp.Diagnostics) {
+		return
 	}
 
 	plan.ID = types.StringValue(clusterID)
@@ -851,6 +1017,11 @@ func (r *clusterNodePoolsResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.State.RemoveResource(ctx)
@@ -891,6 +1062,11 @@ func (r *clusterNodePoolsResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
@@ -903,34 +1079,28 @@ func (r *clusterNodePoolsResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	toDetach, toAttach := sliceDifference(oldIDs, newIDs)
-
-	// Attach new
-	for _, npID := range toAttach {
-		path := fmt.Sprintf("/clusters/%s/node-pools", clusterID)
-		payload := attachNodePoolPayload{NodePoolID: npID}
-		tflog.Info(ctx, "Attaching node pool to cluster (update)", map[string]any{
-			"cluster_id":   clusterID,
-			"node_pool_id": npID,
-		})
-		if err := r.client.doJSON(ctx, http.MethodPost, path, "", payload, nil); err != nil {
-			resp.Diagnostics.AddError("Error attaching node pool to cluster", err.Error())
-			return
-		}
+	// Pools already attached, e.g. by another stack, are adopted without a
+	// request. An exclusive resource detaches everything else attached,
+	// which also covers switching exclusive on; otherwise only pools removed
+	// from node_pool_ids are detached.
+	attached, err := r.attachedNodePoolIDs(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cluster node pools", err.Error())
+		return
 	}
-
-	// Detach removed
-	for _, npID := range toDetach {
-		path := fmt.Sprintf("/clusters/%s/node-pools/%s", clusterID, npID)
-		tflog.Info(ctx, "Detaching node pool from cluster", map[string]any{
-			"cluster_id":   clusterID,
-			"node_pool_id": npID,
-		})
-		if err := r.client.doJSON(ctx, http.MethodDelete, path, "", nil, nil); err != nil {
-			resp.Diagnostics.AddError("Error detaching node pool from cluster", err.Error())
-			return
+	toDetach, toAttach := sliceDifference(attached, newIDs)
+	if !plan.exclusive() {
+		removed, _ := sliceDifference(oldIDs, newIDs)
+		toDetach = nil
+		for _, id := range removed {
+			if slices.Contains(attached, id) {
+				toDetach = append(toDetach, id)
+			}
 		}
 	}
+	if !r.syncNodePools(ctx, clusterID, toAttach, toDetach, &resp.Diagnostics) {
+		return
+	}
 
 	plan.ID = types.StringValue(clusterID)
 
@@ -956,58 +1126,127 @@ func (r *clusterNodePoolsResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		return
 	}
 
+	// Unmanaged pools are never in node_pool_ids, so they stay attached.
 	nodePoolIDs := stringSetToSlice(ctx, state.NodePoolIDs, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	for _, npID := range nodePoolIDs {
+	if !r.syncNodePools(ctx, clusterID, nil, nodePoolIDs, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *clusterNodePoolsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// terraform import autoglue_cluster_node_pools.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
+}
+
+func (r *clusterNodePoolsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// v0 had no exclusive flag and always owned every attached node pool.
+		0: setAttributesUpgrader(map[string]any{
+			"exclusive":               true,
+			"unmanaged_node_pool_ids": []string{},
+		}),
+	}
+}
+
+// syncNodePools attaches toAttach, then detaches toDetach.
+func (r *clusterNodePoolsResource) syncNodePools(ctx context.Context, clusterID string, toAttach, toDetach []string, diags *diag.Diagnostics) bool {
+	for _, npID := range toAttach {
+		path := fmt.Sprintf("/clusters/%s/node-pools", clusterID)
+		payload := attachNodePoolPayload{NodePoolID: npID}
+		tflog.Info(ctx, "Attaching node pool to cluster", map[string]any{
+			"cluster_id":   clusterID,
+			"node_pool_id": npID,
+		})
+		if err := r.client.doJSON(ctx, http.MethodPost, path, "", payload, nil); err != nil {
+			diags.AddError("Error attaching node pool to cluster", err.Error())
+			return false
+		}
+	}
+
+	for _, npID := range toDetach {
 		path := fmt.Sprintf("/clusters/%s/node-pools/%s", clusterID, npID)
-		tflog.Info(ctx, "Detaching node pool from cluster (delete)", map[string]any{
+		tflog.Info(ctx, "Detaching node pool from cluster", map[string]any{
 			"cluster_id":   clusterID,
 			"node_pool_id": npID,
 		})
 		if err := r.client.doJSON(ctx, http.MethodDelete, path, "", nil, nil); err != nil {
-			resp.Diagnostics.AddError("Error detaching node pool from cluster", err.Error())
-			return
+			diags.AddError("Error detaching node pool from cluster", err.Error())
+			return false
 		}
 	}
-
-	resp.State.RemoveResource(ctx)
+	return true
 }
 
-func (r *clusterNodePoolsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_node_pools.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+// attachedNodePoolIDs returns the IDs of every node pool attached to the cluster.
+func (r *clusterNodePoolsResource) attachedNodePoolIDs(ctx context.Context, clusterID string) ([]string, error) {
+	path := fmt.Sprintf("/clusters/%s", clusterID)
+
+	var apiResp cluster
+	if err := r.client.doJSON(ctx, http.MethodGet, path, "", nil, &apiResp); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(apiResp.NodePools))
+	for _, np := range apiResp.NodePools {
+		ids = append(ids, np.ID)
+	}
+	return ids, nil
 }
 
+// readNodePoolsIntoModel splits the attached node pools into node_pool_ids
+// and unmanaged_node_pool_ids. An exclusive resource manages all of them;
+// otherwise only those already in model.NodePoolIDs are managed.
 func (r *clusterNodePoolsResource) readNodePoolsIntoModel(
 	ctx context.Context,
 	clusterID string,
 	model *clusterNodePoolsModel,
 	diags *diag.Diagnostics,
 ) error {
-	path := fmt.Sprintf("/clusters/%s", clusterID)
-
-	var apiResp cluster
-	if err := r.client.doJSON(ctx, http.MethodGet, path, "", nil, &apiResp); err != nil {
+	attached, err := r.attachedNodePoolIDs(ctx, clusterID)
+	if err != nil {
 		return err
 	}
 
-	var ids []string
-	for _, np := range apiResp.NodePools {
-		ids = append(ids, np.ID)
+	managed, unmanaged := attached, []string{}
+	if !model.exclusive() {
+		listed := map[string]bool{}
+		for _, id := range stringSetToSlice(ctx, model.NodePoolIDs, diags) {
+			listed[id] = true
+		}
+		managed = []string{}
+		for _, id := range attached {
+			if listed[id] {
+				managed = append(managed, id)
+			} else {
+				unmanaged = append(unmanaged, id)
+			}
+		}
 	}
 
-	setVal, d := types.SetValueFrom(ctx, types.StringType, ids)
+	setVal, d := types.SetValueFrom(ctx, types.StringType, managed)
+	diags.Append(d...)
+	unmanagedVal, d := types.SetValueFrom(ctx, types.StringType, unmanaged)
 	diags.Append(d...)
 
 	model.NodePoolIDs = setVal
+	model.UnmanagedNodePoolIDs = unmanagedVal
+	model.Exclusive = types.BoolValue(model.exclusive())
 	model.ClusterID = types.StringValue(clusterID)
 	model.ID = types.StringValue(clusterID)
 
@@ -1019,9 +1258,10 @@ type setKubeconfigPayload struct {
 }
 
 var (
-	_ resource.Resource                = &clusterKubeconfigResource{}
-	_ resource.ResourceWithConfigure   = &clusterKubeconfigResource{}
-	_ resource.ResourceWithImportState = &clusterKubeconfigResource{}
+	_ resource.Resource                 = &clusterKubeconfigResource{}
+	_ resource.ResourceWithConfigure    = &clusterKubeconfigResource{}
+	_ resource.ResourceWithImportState  = &clusterKubeconfigResource{}
+	_ resource.ResourceWithUpgradeState = &clusterKubeconfigResource{}
 )
 
 type clusterKubeconfigResource struct {
@@ -1029,9 +1269,12 @@ type clusterKubeconfigResource struct {
 }
 
 type clusterKubeconfigModel struct {
-	ID         types.String `tfsdk:"id"`
-	ClusterID  types.String `tfsdk:"cluster_id"`
-	Kubeconfig types.String `tfsdk:"kubeconfig"`
+	ID                types.String `tfsdk:"id"`
+	ClusterID         types.String `tfsdk:"cluster_id"`
+	Kubeconfig        types.String `tfsdk:"kubeconfig"`
+	KubeconfigVersion types.Int64  `tfsdk:"kubeconfig_version"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewClusterKubeconfigResource() resource.Resource {
@@ -1045,7 +1288,9 @@ func (r *clusterKubeconfigResource) Metadata(_ context.Context, req resource.Met
 func (r *clusterKubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resourceschema.Schema{
 		Description: "Manages a cluster's kubeconfig (write-only). " +
-			"The kubeconfig is encrypted server-side and never returned by the API.",
+			"The kubeconfig is encrypted server-side and never returned by the API, so no data source or " +
+			"ephemeral resource can read it back.",
+		Version: 1,
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.StringAttribute{
 				Computed:    true,
@@ -1061,9 +1306,16 @@ func (r *clusterKubeconfigResource) Schema(_ context.Context, _ resource.SchemaR
 			"kubeconfig": resourceschema.StringAttribute{
 				Required:  true,
 				Sensitive: true,
-				Description: "Kubeconfig YAML for the cluster. " +
-					"This value is never read back from the API; it only drives writes.",
+				WriteOnly: true,
+				Description: "Kubeconfig YAML for the cluster. Write-only (requires Terraform 1.11+): " +
+					"sent when the resource is created and whenever `kubeconfig_version` changes, never stored in plan or state.",
 			},
+			"kubeconfig_version": resourceschema.Int64Attribute{
+				Optional: true,
+				Description: "Arbitrary version number for `kubeconfig`. Terraform can't detect changes to a write-only value, " +
+					"so change this (e.g. increment it) to send an updated `kubeconfig` to the API.",
+			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -1089,9 +1341,16 @@ func (r *clusterKubeconfigResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	var plan clusterKubeconfigModel
+	var plan, config clusterKubeconfigModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1102,8 +1361,9 @@ func (r *clusterKubeconfigResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	// kubeconfig is write-only, so it's only available from config.
 	payload := setKubeconfigPayload{
-		Kubeconfig: plan.Kubeconfig.ValueString(),
+		Kubeconfig: config.Kubeconfig.ValueString(),
 	}
 
 	path := fmt.Sprintf("/clusters/%s/kubeconfig", clusterID)
@@ -1136,6 +1396,11 @@ func (r *clusterKubeconfigResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.State.RemoveResource(ctx)
@@ -1153,7 +1418,6 @@ func (r *clusterKubeconfigResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	// We don't touch state.Kubeconfig; it's write-only and persisted from state.
 	state.ID = types.StringValue(clusterID)
 
 	diags = resp.State.Set(ctx, &state)
@@ -1166,11 +1430,13 @@ func (r *clusterKubeconfigResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	var plan clusterKubeconfigModel
+	var plan, config clusterKubeconfigModel
 	var state clusterKubeconfigModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1180,18 +1446,27 @@ func (r *clusterKubeconfigResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
 		return
 	}
 
-	if plan.Kubeconfig.Equal(state.Kubeconfig) {
+	plan.ID = types.StringValue(clusterID)
+
+	if plan.KubeconfigVersion.Equal(state.KubeconfigVersion) {
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
 	payload := setKubeconfigPayload{
-		Kubeconfig: plan.Kubeconfig.ValueString(),
+		Kubeconfig: config.Kubeconfig.ValueString(),
 	}
 	path := fmt.Sprintf("/clusters/%s/kubeconfig", clusterID)
 
@@ -1204,8 +1479,6 @@ func (r *clusterKubeconfigResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	plan.ID = types.StringValue(clusterID)
-
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -1223,6 +1496,11 @@ func (r *clusterKubeconfigResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		return
@@ -1243,14 +1521,22 @@ func (r *clusterKubeconfigResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *clusterKubeconfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_kubeconfig.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	// terraform import autoglue_cluster_kubeconfig.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
+}
+
+func (r *clusterKubeconfigResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// v0 stored kubeconfig in state.
+		0: nullAttributesUpgrader("kubeconfig", "kubeconfig_version"),
+	}
 }
 
 var (
 	_ resource.Resource                = &clusterGlueOpsLoadBalancerResource{}
 	_ resource.ResourceWithConfigure   = &clusterGlueOpsLoadBalancerResource{}
 	_ resource.ResourceWithImportState = &clusterGlueOpsLoadBalancerResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterGlueOpsLoadBalancerResource{}
 )
 
 type clusterGlueOpsLoadBalancerResource struct {
@@ -1261,6 +1547,8 @@ type clusterGlueOpsLoadBalancerModel struct {
 	ID             types.String `tfsdk:"id"`
 	ClusterID      types.String `tfsdk:"cluster_id"`
 	LoadBalancerID types.String `tfsdk:"load_balancer_id"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewClusterGlueOpsLoadBalancerResource() resource.Resource {
@@ -1290,6 +1578,7 @@ func (r *clusterGlueOpsLoadBalancerResource) Schema(_ context.Context, _ resourc
 				Required:    true,
 				Description: "Load balancer ID to attach as GlueOps/control-plane LB.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -1309,6 +1598,23 @@ func (r *clusterGlueOpsLoadBalancerResource) Configure(_ context.Context, req re
 	r.client = client
 }
 
+// ModifyPlan checks that the load balancer exists and is a GlueOps one.
+func (r *clusterGlueOpsLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	attr := path.Root("load_balancer_id")
+	id := changedAttachmentID(ctx, req, attr, &resp.Diagnostics)
+	if id == "" {
+		return
+	}
+	ctx = r.client.attachmentCheckContext(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client.checkAttachmentLoadBalancer(ctx, attr, id, "glueops", "autoglue_cluster_glueops_load_balancer", &resp.Diagnostics)
+}
+
 func (r *clusterGlueOpsLoadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
@@ -1322,6 +1628,11 @@ func (r *clusterGlueOpsLoadBalancerResource) Create(ctx context.Context, req res
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := plan.ClusterID.ValueString()
 	lbID := plan.LoadBalancerID.ValueString()
 	if clusterID == "" || lbID == "" {
@@ -1361,6 +1672,11 @@ func (r *clusterGlueOpsLoadBalancerResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.State.RemoveResource(ctx)
@@ -1410,6 +1726,11 @@ func (r *clusterGlueOpsLoadBalancerResource) Update(ctx context.Context, req res
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
@@ -1452,6 +1773,11 @@ func (r *clusterGlueOpsLoadBalancerResource) Delete(ctx context.Context, req res
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		return
@@ -1472,8 +1798,8 @@ func (r *clusterGlueOpsLoadBalancerResource) Delete(ctx context.Context, req res
 }
 
 func (r *clusterGlueOpsLoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_glueops_load_balancer.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	// terraform import autoglue_cluster_glueops_load_balancer.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
 }
 
 type attachBastionPayload struct {
@@ -1484,6 +1810,7 @@ var (
 	_ resource.Resource                = &clusterBastionResource{}
 	_ resource.ResourceWithConfigure   = &clusterBastionResource{}
 	_ resource.ResourceWithImportState = &clusterBastionResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterBastionResource{}
 )
 
 type clusterBastionResource struct {
@@ -1494,6 +1821,8 @@ type clusterBastionModel struct {
 	ID        types.String `tfsdk:"id"`
 	ClusterID types.String `tfsdk:"cluster_id"`
 	ServerID  types.String `tfsdk:"server_id"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewClusterBastionResource() resource.Resource {
@@ -1523,6 +1852,7 @@ func (r *clusterBastionResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:    true,
 				Description: "Server ID to attach as bastion.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -1542,6 +1872,23 @@ func (r *clusterBastionResource) Configure(_ context.Context, req resource.Confi
 	r.client = client
 }
 
+// ModifyPlan checks that the server exists and has the bastion role.
+func (r *clusterBastionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	attr := path.Root("server_id")
+	id := changedAttachmentID(ctx, req, attr, &resp.Diagnostics)
+	if id == "" {
+		return
+	}
+	ctx = r.client.attachmentCheckContext(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client.checkAttachmentBastion(ctx, attr, id, "autoglue_cluster_bastion", &resp.Diagnostics)
+}
+
 func (r *clusterBastionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
@@ -1555,6 +1902,11 @@ func (r *clusterBastionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := plan.ClusterID.ValueString()
 	serverID := plan.ServerID.ValueString()
 	if clusterID == "" || serverID == "" {
@@ -1594,6 +1946,11 @@ func (r *clusterBastionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.State.RemoveResource(ctx)
@@ -1643,6 +2000,11 @@ func (r *clusterBastionResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id must be set in state.")
@@ -1685,6 +2047,11 @@ func (r *clusterBastionResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	if clusterID == "" {
 		return
@@ -1705,6 +2072,6 @@ func (r *clusterBastionResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *clusterBastionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_bastion.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+	// terraform import autoglue_cluster_bastion.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("cluster_id"), req, resp)
 }
@@ -0,0 +1,551 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClusterAttachmentResources(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+
+	cred := applyTestCredential(t, h)
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{"domain_name": "example.com", "credential_id": cred.Attr("id")})
+	rs := h.resource("autoglue_record_set")
+	rs.Apply(map[string]any{
+		"domain_id": domain.Attr("id"),
+		"name":      "cp",
+		"type":      "A",
+		"values":    []string{"203.0.113.1"},
+	})
+	appsLB := h.resource("autoglue_load_balancer")
+	appsLB.Apply(map[string]any{"name": "apps", "kind": "public"})
+	cpLB := h.resource("autoglue_load_balancer")
+	cpLB.Apply(map[string]any{"name": "cp", "kind": "glueops"})
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "bastion"})
+	bastion := h.resource("autoglue_server")
+	bastion.Apply(map[string]any{"hostname": "bastion", "role": "bastion", "ssh_key_id": key.Attr("id"), "ssh_user": "ubuntu"})
+
+	cases := []struct {
+		typeName string
+		field    string
+		value    string
+		attached func() string
+	}{
+		{"autoglue_cluster_captain_domain", "domain_id", domain.Attr("id"), func() string { return api.clusters[clusterID].captainDomainID }},
+		{"autoglue_cluster_control_plane_record_set", "record_set_id", rs.Attr("id"), func() string { return api.clusters[clusterID].controlPlaneRecordSetID }},
+		{"autoglue_cluster_apps_load_balancer", "load_balancer_id", appsLB.Attr("id"), func() string { return api.clusters[clusterID].appsLoadBalancerID }},
+		{"autoglue_cluster_glueops_load_balancer", "load_balancer_id", cpLB.Attr("id"), func() string { return api.clusters[clusterID].glueOpsLoadBalancerID }},
+		{"autoglue_cluster_bastion", "server_id", bastion.Attr("id"), func() string { return api.clusters[clusterID].bastionServerID }},
+	}
+
+	var attachments []*testResource
+	for _, tc := range cases {
+		att := h.resource(tc.typeName)
+		att.Apply(map[string]any{"cluster_id": clusterID, tc.field: tc.value})
+		if got := tc.attached(); got != tc.value {
+			t.Errorf("%s: attached %q, want %q", tc.typeName, got, tc.value)
+		}
+		att.ImportVerify(clusterID)
+		attachments = append(attachments, att)
+	}
+
+	cluster.Refresh()
+	if got := cluster.Attr("control_plane_fqdn"); got != "cp.example.com" {
+		t.Errorf("control_plane_fqdn = %q, want cp.example.com", got)
+	}
+	if got := cluster.Attr("bastion_server_id"); got != bastion.Attr("id") {
+		t.Errorf("bastion_server_id = %q, want %q", got, bastion.Attr("id"))
+	}
+
+	for i, att := range attachments {
+		att.Destroy()
+		if got := cases[i].attached(); got != "" {
+			t.Errorf("%s: still attached after destroy: %q", cases[i].typeName, got)
+		}
+	}
+}
+
+func TestClusterNodePoolsResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+
+	var pools []string
+	for _, name := range []string{"masters", "workers-a", "workers-b"} {
+		np := h.resource("autoglue_node_pool")
+		role := "worker"
+		if name == "masters" {
+			role = "master"
+		}
+		np.Apply(map[string]any{"name": name, "role": role})
+		pools = append(pools, np.Attr("id"))
+	}
+
+	att := h.resource("autoglue_cluster_node_pools")
+	att.Apply(map[string]any{"cluster_id": clusterID, "node_pool_ids": pools[:2]})
+	if got := attachedIDs(api.clusters[clusterID].nodePoolIDs); !reflect.DeepEqual(got, pools[:2]) {
+		t.Fatalf("attached = %v, want %v", got, pools[:2])
+	}
+
+	att.Apply(map[string]any{"cluster_id": clusterID, "node_pool_ids": []string{pools[0], pools[2]}})
+	if got := attachedIDs(api.clusters[clusterID].nodePoolIDs); !reflect.DeepEqual(got, []string{pools[0], pools[2]}) {
+		t.Fatalf("attached = %v, want %v", got, []string{pools[0], pools[2]})
+	}
+
+	att.ImportVerify(clusterID)
+
+	att.Destroy()
+	if got := api.clusters[clusterID].nodePoolIDs; len(got) != 0 {
+		t.Fatalf("expected all node pools detached, got %v", got)
+	}
+}
+
+func TestClusterNodePoolsResource_Exclusive(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+
+	var pools []string
+	for _, name := range []string{"workers-a", "workers-b", "workers-c"} {
+		np := h.resource("autoglue_node_pool")
+		np.Apply(map[string]any{"name": name, "role": "worker"})
+		pools = append(pools, np.Attr("id"))
+	}
+
+	t.Run("exclusive", func(t *testing.T) {
+		// A pool attached before the resource exists is detached on create.
+		api.clusters[clusterID].nodePoolIDs[pools[2]] = true
+		config := map[string]any{"cluster_id": clusterID, "node_pool_ids": pools[:2]}
+
+		att := h.resource("autoglue_cluster_node_pools")
+		att.Apply(config)
+		if got, want := attachedIDs(api.clusters[clusterID].nodePoolIDs), attachedIDs(map[string]bool{pools[0]: true, pools[1]: true}); !reflect.DeepEqual(got, want) {
+			t.Fatalf("attached = %v, want %v", got, want)
+		}
+		if att.Attr("exclusive") != "true" {
+			t.Errorf("exclusive = %s, want true by default", att.Attr("exclusive"))
+		}
+		if got := stringElems(t, att.Attrs()["unmanaged_node_pool_ids"]); len(got) != 0 {
+			t.Errorf("unmanaged_node_pool_ids = %v, want empty", got)
+		}
+
+		// One attached out-of-band is drift, and is detached on the next apply.
+		api.clusters[clusterID].nodePoolIDs[pools[2]] = true
+		att.Refresh()
+		if got := stringElems(t, att.Attrs()["node_pool_ids"]); len(got) != 3 {
+			t.Fatalf("node_pool_ids after refresh = %v, want all three pools", got)
+		}
+		att.Apply(config)
+		if api.clusters[clusterID].nodePoolIDs[pools[2]] {
+			t.Errorf("out-of-band node pool %s still attached", pools[2])
+		}
+		att.ExpectNoChanges(config)
+
+		att.Destroy()
+		if got := api.clusters[clusterID].nodePoolIDs; len(got) != 0 {
+			t.Fatalf("expected all node pools detached, got %v", got)
+		}
+	})
+
+	t.Run("non-exclusive", func(t *testing.T) {
+		// Attached by another stack.
+		api.clusters[clusterID].nodePoolIDs[pools[0]] = true
+		config := map[string]any{"cluster_id": clusterID, "node_pool_ids": pools[1:2], "exclusive": false}
+
+		att := h.resource("autoglue_cluster_node_pools")
+		att.Apply(config)
+		if got, want := attachedIDs(api.clusters[clusterID].nodePoolIDs), attachedIDs(map[string]bool{pools[0]: true, pools[1]: true}); !reflect.DeepEqual(got, want) {
+			t.Fatalf("attached = %v, want %v", got, want)
+		}
+		if got := stringElems(t, att.Attrs()["unmanaged_node_pool_ids"]); !reflect.DeepEqual(got, pools[:1]) {
+			t.Errorf("unmanaged_node_pool_ids = %v, want %v", got, pools[:1])
+		}
+		att.ExpectNoChanges(config)
+
+		// Foreign attachments come and go without affecting the plan.
+		api.clusters[clusterID].nodePoolIDs[pools[2]] = true
+		att.Refresh()
+		if got, want := stringElems(t, att.Attrs()["unmanaged_node_pool_ids"]), attachedIDs(map[string]bool{pools[0]: true, pools[2]: true}); !reflect.DeepEqual(got, want) {
+			t.Errorf("unmanaged_node_pool_ids = %v, want %v", got, want)
+		}
+		if got := stringElems(t, att.Attrs()["node_pool_ids"]); !reflect.DeepEqual(got, pools[1:2]) {
+			t.Errorf("node_pool_ids = %v, want %v", got, pools[1:2])
+		}
+		att.ExpectNoChanges(config)
+
+		// Updates only touch the listed pools.
+		config["node_pool_ids"] = []string{pools[1], pools[2]}
+		before := len(api.Requests())
+		att.Apply(config)
+		if writes := writesSince(api, before); len(writes) != 0 {
+			t.Errorf("adopting an already attached pool made writes: %v", writes)
+		}
+		if got := stringElems(t, att.Attrs()["unmanaged_node_pool_ids"]); !reflect.DeepEqual(got, pools[:1]) {
+			t.Errorf("unmanaged_node_pool_ids = %v, want %v", got, pools[:1])
+		}
+
+		att.Destroy()
+		if got := attachedIDs(api.clusters[clusterID].nodePoolIDs); !reflect.DeepEqual(got, pools[:1]) {
+			t.Fatalf("attached after destroy = %v, want only the unmanaged %v", got, pools[:1])
+		}
+	})
+
+	t.Run("upgraded from v0", func(t *testing.T) {
+		// Version 0 of the schema had no exclusive flag and always owned
+		// every attached pool; upgraded state must plan no changes.
+		config := map[string]any{"cluster_id": clusterID, "node_pool_ids": pools[:1]}
+		upgraded := h.UpgradeState("autoglue_cluster_node_pools", 0, map[string]any{
+			"id":            clusterID,
+			"cluster_id":    clusterID,
+			"node_pool_ids": pools[:1],
+		})
+		if upgraded.Attr("exclusive") != "true" {
+			t.Errorf("exclusive = %s after upgrade, want true", upgraded.Attr("exclusive"))
+		}
+		upgraded.ExpectNoChanges(config)
+	})
+}
+
+func TestClusterKubeconfigResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+
+	kc := h.resource("autoglue_cluster_kubeconfig")
+	kc.Apply(map[string]any{"cluster_id": clusterID, "kubeconfig": "apiVersion: v1\nkind: Config\n"})
+	if got := api.clusters[clusterID].kubeconfig; got != "apiVersion: v1\nkind: Config\n" {
+		t.Errorf("kubeconfig not stored, got %q", got)
+	}
+	if !kc.Attrs()["kubeconfig"].IsNull() {
+		t.Errorf("kubeconfig is write-only but was stored in state")
+	}
+
+	// A changed kubeconfig is only sent when kubeconfig_version changes.
+	kc.Apply(map[string]any{"cluster_id": clusterID, "kubeconfig": "apiVersion: v1\nkind: Config\nclusters: []\n"})
+	if got := api.clusters[clusterID].kubeconfig; got != "apiVersion: v1\nkind: Config\n" {
+		t.Errorf("kubeconfig sent without a kubeconfig_version change, got %q", got)
+	}
+	kc.Apply(map[string]any{
+		"cluster_id":         clusterID,
+		"kubeconfig":         "apiVersion: v1\nkind: Config\nclusters: []\n",
+		"kubeconfig_version": 2,
+	})
+	if got := api.clusters[clusterID].kubeconfig; got != "apiVersion: v1\nkind: Config\nclusters: []\n" {
+		t.Errorf("kubeconfig not updated, got %q", got)
+	}
+
+	// kubeconfig_version only exists in config.
+	kc.ImportVerify(clusterID, "kubeconfig_version")
+
+	// Version 0 of the schema stored the kubeconfig in state.
+	upgraded := h.UpgradeState("autoglue_cluster_kubeconfig", 0, map[string]any{
+		"id":         clusterID,
+		"cluster_id": clusterID,
+		"kubeconfig": "apiVersion: v1\nkind: Config\n",
+	})
+	if !upgraded.Attrs()["kubeconfig"].IsNull() {
+		t.Errorf("kubeconfig not removed from upgraded state")
+	}
+
+	kc.Destroy()
+	if got := api.clusters[clusterID].kubeconfig; got != "" {
+		t.Errorf("kubeconfig not cleared, got %q", got)
+	}
+}
+
+// testKubeconfig is sent as the write-only kubeconfig of test clusters.
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: Y2EtcGVt
+    server: https://10.0.0.10:6443
+  name: prod
+- cluster:
+    certificate-authority-data: b3RoZXItY2E=
+    server: "https://staging.example.com:6443"
+  name: staging
+contexts:
+- context:
+    cluster: prod
+    user: prod-admin
+  name: prod
+- context:
+    cluster: staging
+    user: staging-admin
+  name: staging
+current-context: prod # set by autoglue
+preferences: {}
+users:
+- name: prod-admin
+  user:
+    client-certificate-data: Y2VydC1wZW0=
+    client-key-data: a2V5LXBlbQ==
+- name: staging-admin
+  user:
+    token: 's3cr''et'
+`
+
+// writesSince returns the non-GET requests made after the first n requests.
+func writesSince(api *fakeAPI, n int) []string {
+	var out []string
+	for _, line := range api.Requests()[n:] {
+		if !strings.HasPrefix(line, "GET ") {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+func TestClusterAttachmentsResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+	c := api.clusters[clusterID]
+
+	cred := applyTestCredential(t, h)
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{"domain_name": "example.com", "credential_id": cred.Attr("id")})
+	rs := h.resource("autoglue_record_set")
+	rs.Apply(map[string]any{
+		"domain_id": domain.Attr("id"),
+		"name":      "cp",
+		"type":      "A",
+		"values":    []string{"203.0.113.1"},
+	})
+	appsLB := h.resource("autoglue_load_balancer")
+	appsLB.Apply(map[string]any{"name": "apps", "kind": "public"})
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "bastion"})
+	bastion := h.resource("autoglue_server")
+	bastion.Apply(map[string]any{"hostname": "bastion", "role": "bastion", "ssh_key_id": key.Attr("id"), "ssh_user": "ubuntu"})
+	var pools []string
+	for _, name := range []string{"masters", "workers-a", "workers-b"} {
+		np := h.resource("autoglue_node_pool")
+		np.Apply(map[string]any{"name": name, "role": "worker"})
+		pools = append(pools, np.Attr("id"))
+	}
+
+	config := map[string]any{
+		"cluster_id":               clusterID,
+		"captain_domain":           map[string]any{"domain_id": domain.Attr("id")},
+		"control_plane_record_set": map[string]any{"record_set_id": rs.Attr("id")},
+		"node_pools":               map[string]any{"node_pool_ids": pools[:2]},
+		"kubeconfig":               map[string]any{"kubeconfig": testKubeconfig},
+	}
+	att := h.resource("autoglue_cluster_attachments")
+	n := len(api.Requests())
+	att.Apply(config)
+	want := []string{
+		"POST /clusters/" + clusterID + "/captain-domain",
+		"POST /clusters/" + clusterID + "/control-plane-record-set",
+		"POST /clusters/" + clusterID + "/node-pools",
+		"POST /clusters/" + clusterID + "/node-pools",
+		"POST /clusters/" + clusterID + "/kubeconfig",
+	}
+	if got := writesSince(api, n); !reflect.DeepEqual(got, want) {
+		t.Errorf("create calls = %v, want %v", got, want)
+	}
+	if c.captainDomainID != domain.Attr("id") || c.controlPlaneRecordSetID != rs.Attr("id") || c.kubeconfig != testKubeconfig {
+		t.Errorf("attachments not applied: %+v", c)
+	}
+	att.ExpectNoChanges(config)
+
+	// Only the changed attachments are sent; the kubeconfig isn't resent.
+	config["node_pools"] = map[string]any{"node_pool_ids": []string{pools[0], pools[2]}}
+	config["bastion"] = map[string]any{"server_id": bastion.Attr("id")}
+	config["apps_load_balancer"] = map[string]any{"load_balancer_id": appsLB.Attr("id")}
+	n = len(api.Requests())
+	att.Apply(config)
+	want = []string{
+		"DELETE /clusters/" + clusterID + "/node-pools/" + pools[1],
+		"POST /clusters/" + clusterID + "/apps-load-balancer",
+		"POST /clusters/" + clusterID + "/bastion",
+		"POST /clusters/" + clusterID + "/node-pools",
+	}
+	if got := writesSince(api, n); !reflect.DeepEqual(got, want) {
+		t.Errorf("update calls = %v, want %v", got, want)
+	}
+	if got := attachedIDs(c.nodePoolIDs); !reflect.DeepEqual(got, []string{pools[0], pools[2]}) {
+		t.Errorf("node pools = %v, want %v", got, []string{pools[0], pools[2]})
+	}
+
+	// The whole set is imported from the cluster ID; the kubeconfig can't be read back.
+	imported := att.ImportVerify(clusterID, "kubeconfig")
+	if !imported.Attrs()["kubeconfig"].IsNull() {
+		t.Errorf("imported kubeconfig = %v, want null", imported.Attrs()["kubeconfig"])
+	}
+
+	// Out-of-band detaches show up as drift.
+	c.bastionServerID = ""
+	att.Refresh()
+	if !att.Attrs()["bastion"].IsNull() {
+		t.Errorf("bastion = %v after out-of-band detach, want null", att.Attrs()["bastion"])
+	}
+	n = len(api.Requests())
+	att.Apply(config)
+	if got, want := writesSince(api, n), []string{"POST /clusters/" + clusterID + "/bastion"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("re-attach calls = %v, want %v", got, want)
+	}
+
+	// Detaches run before attaches, dependents first.
+	delete(config, "captain_domain")
+	delete(config, "control_plane_record_set")
+	config["kubeconfig"] = map[string]any{"kubeconfig": testKubeconfig, "kubeconfig_version": 2}
+	n = len(api.Requests())
+	att.Apply(config)
+	want = []string{
+		"DELETE /clusters/" + clusterID + "/control-plane-record-set",
+		"DELETE /clusters/" + clusterID + "/captain-domain",
+		"POST /clusters/" + clusterID + "/kubeconfig",
+	}
+	if got := writesSince(api, n); !reflect.DeepEqual(got, want) {
+		t.Errorf("detach calls = %v, want %v", got, want)
+	}
+
+	config["bastion"] = map[string]any{}
+	att.ApplyExpectError(config, `"bastion.server_id" must be specified`)
+
+	att.Destroy()
+	if c.appsLoadBalancerID != "" || c.bastionServerID != "" || len(c.nodePoolIDs) != 0 || c.kubeconfig != "" {
+		t.Errorf("attachments left after destroy: %+v", c)
+	}
+}
+
+func TestClusterAttachmentsResource_PartialFailure(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+	lb := h.resource("autoglue_load_balancer")
+	lb.Apply(map[string]any{"name": "apps", "kind": "public"})
+
+	att := h.resource("autoglue_cluster_attachments")
+	att.Apply(map[string]any{"cluster_id": clusterID})
+	config := map[string]any{
+		"cluster_id":         clusterID,
+		"apps_load_balancer": map[string]any{"load_balancer_id": lb.Attr("id")},
+		"node_pools":         map[string]any{"node_pool_ids": []string{"does-not-exist"}},
+	}
+	att.ApplyExpectError(config, "unknown node_pool_id")
+
+	// The attachment that succeeded is recorded, so only the node pool is retried.
+	if got := valueString(t, objectAttrs(t, att.Attrs()["apps_load_balancer"])["load_balancer_id"]); got != lb.Attr("id") {
+		t.Errorf("apps_load_balancer.load_balancer_id = %q, want %q", got, lb.Attr("id"))
+	}
+	if !att.Attrs()["node_pools"].IsNull() {
+		t.Errorf("node_pools = %v, want null", att.Attrs()["node_pools"])
+	}
+
+	delete(config, "node_pools")
+	n := len(api.Requests())
+	att.Apply(config)
+	if got := writesSince(api, n); len(got) != 0 {
+		t.Errorf("expected no calls once the failed attachment is dropped, got %v", got)
+	}
+}
+
+func TestClusterAttachmentValidation(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+
+	cred := applyTestCredential(t, h)
+	var domains, recordSets []string
+	for _, name := range []string{"example.com", "example.org"} {
+		d := h.resource("autoglue_domain")
+		d.Apply(map[string]any{"domain_name": name, "credential_id": cred.Attr("id")})
+		rs := h.resource("autoglue_record_set")
+		rs.Apply(map[string]any{"domain_id": d.Attr("id"), "name": "cp", "type": "A", "values": []string{"203.0.113.1"}})
+		domains = append(domains, d.Attr("id"))
+		recordSets = append(recordSets, rs.Attr("id"))
+	}
+	publicLB := h.resource("autoglue_load_balancer")
+	publicLB.Apply(map[string]any{"name": "apps", "kind": "public"})
+	glueopsLB := h.resource("autoglue_load_balancer")
+	glueopsLB.Apply(map[string]any{"name": "cp", "kind": "glueops"})
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "k"})
+	worker := h.resource("autoglue_server")
+	worker.Apply(map[string]any{"hostname": "worker-1", "role": "worker", "ssh_key_id": key.Attr("id"), "ssh_user": "ubuntu"})
+
+	n := len(api.Requests())
+	h.resource("autoglue_cluster_glueops_load_balancer").ApplyExpectError(
+		map[string]any{"cluster_id": clusterID, "load_balancer_id": publicLB.Attr("id")},
+		"is kind=public but autoglue_cluster_glueops_load_balancer requires kind=glueops")
+	h.resource("autoglue_cluster_apps_load_balancer").ApplyExpectError(
+		map[string]any{"cluster_id": clusterID, "load_balancer_id": glueopsLB.Attr("id")},
+		"is kind=glueops but autoglue_cluster_apps_load_balancer requires kind=public")
+	h.resource("autoglue_cluster_bastion").ApplyExpectError(
+		map[string]any{"cluster_id": clusterID, "server_id": worker.Attr("id")},
+		`has role="worker" but autoglue_cluster_bastion requires role=bastion`)
+	h.resource("autoglue_cluster_captain_domain").ApplyExpectError(
+		map[string]any{"cluster_id": clusterID, "domain_id": "missing"},
+		"No domain with ID missing exists")
+	if got := writesSince(api, n); len(got) != 0 {
+		t.Errorf("invalid attachments reached the API: %v", got)
+	}
+
+	captain := h.resource("autoglue_cluster_captain_domain")
+	captain.Apply(map[string]any{"cluster_id": clusterID, "domain_id": domains[0]})
+	h.resource("autoglue_cluster_control_plane_record_set").ApplyExpectError(
+		map[string]any{"cluster_id": clusterID, "record_set_id": recordSets[1]},
+		"domain_id "+domains[1]+" does not match captain domain "+domains[0])
+	captain.Destroy()
+
+	att := h.resource("autoglue_cluster_attachments")
+	att.ApplyExpectError(map[string]any{
+		"cluster_id":               clusterID,
+		"control_plane_record_set": map[string]any{"record_set_id": recordSets[0]},
+	}, `"captain_domain" must be specified`)
+	att.ApplyExpectError(map[string]any{
+		"cluster_id":               clusterID,
+		"captain_domain":           map[string]any{"domain_id": domains[0]},
+		"control_plane_record_set": map[string]any{"record_set_id": recordSets[1]},
+	}, "does not match captain domain")
+	att.ApplyExpectError(map[string]any{
+		"cluster_id":            clusterID,
+		"glueops_load_balancer": map[string]any{"load_balancer_id": publicLB.Attr("id")},
+	}, "is kind=public but glueops_load_balancer requires kind=glueops")
+
+	config := map[string]any{
+		"cluster_id":               clusterID,
+		"captain_domain":           map[string]any{"domain_id": domains[0]},
+		"control_plane_record_set": map[string]any{"record_set_id": recordSets[0]},
+		"glueops_load_balancer":    map[string]any{"load_balancer_id": glueopsLB.Attr("id")},
+	}
+	att.Apply(config)
+
+	// Unchanged attachments aren't looked up again.
+	lookup := "GET /load-balancers/" + glueopsLB.Attr("id")
+	before := countRequests(api, lookup)
+	att.ExpectNoChanges(config)
+	if got := countRequests(api, lookup) - before; got != 0 {
+		t.Errorf("unchanged load balancer looked up %d times", got)
+	}
+}
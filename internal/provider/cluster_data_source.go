@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strings"
 
@@ -23,8 +22,13 @@ type clustersDataSource struct {
 }
 
 type clustersDataSourceModel struct {
-	Search   types.String            `tfsdk:"search"`
-	Clusters []clusterDataSourceItem `tfsdk:"clusters"`
+	Search          types.String            `tfsdk:"search"`
+	Status          types.String            `tfsdk:"status"`
+	Region          types.String            `tfsdk:"region"`
+	ClusterProvider types.String            `tfsdk:"cluster_provider"`
+	Clusters        []clusterDataSourceItem `tfsdk:"clusters"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type clusterDataSourceItem struct {
@@ -69,89 +73,107 @@ func (d *clustersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Optional:    true,
 				Description: "Optional substring filter over cluster name (maps to `q`).",
 			},
+			"status": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional status filter (for example `pre_pending`, `pending`, `provisioning`, `ready`, `failed`).",
+			},
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional region filter.",
+			},
+			"cluster_provider": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional cluster provider filter.",
+			},
 
 			"clusters": dsschema.ListNestedAttribute{
 				Computed:    true,
 				Description: "Matching clusters.",
 				NestedObject: dsschema.NestedAttributeObject{
-					Attributes: map[string]dsschema.Attribute{
-						"id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Cluster ID.",
-						},
-						"name": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Cluster name.",
-						},
-						"cluster_provider": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Cluster provider.",
-						},
-						"region": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Cluster region.",
-						},
-						"status": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Cluster status.",
-						},
-						"last_error": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last error message.",
-						},
-						"random_token": dsschema.StringAttribute{
-							Computed:    true,
-							Sensitive:   true,
-							Description: "Random token for the cluster.",
-						},
-						"certificate_key": dsschema.StringAttribute{
-							Computed:    true,
-							Sensitive:   true,
-							Description: "Cluster certificate key.",
-						},
-						"captain_domain_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Attached captain domain ID, if any.",
-						},
-						"control_plane_record_set_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Attached control plane record set ID, if any.",
-						},
-						"control_plane_fqdn": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Control plane FQDN, if present.",
-						},
-						"apps_load_balancer_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Attached apps load balancer ID, if any.",
-						},
-						"glueops_load_balancer_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Attached GlueOps load balancer ID, if any.",
-						},
-						"bastion_server_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Attached bastion server ID, if any.",
-						},
-						"docker_image": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Docker image.",
-						},
-						"docker_tag": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Docker tag.",
-						},
-						"created_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Creation timestamp.",
-						},
-						"updated_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last update timestamp.",
-						},
-					},
+					Attributes: clusterDataSourceAttributes(),
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// clusterDataSourceAttributes describes a cluster as exposed by the cluster and clusters data sources.
+func clusterDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Cluster ID.",
+		},
+		"name": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Cluster name.",
+		},
+		"cluster_provider": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Cluster provider.",
+		},
+		"region": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Cluster region.",
+		},
+		"status": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Cluster status.",
+		},
+		"last_error": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last error message.",
+		},
+		"random_token": dsschema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Random token for the cluster.",
+		},
+		"certificate_key": dsschema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Cluster certificate key.",
+		},
+		"captain_domain_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Attached captain domain ID, if any.",
+		},
+		"control_plane_record_set_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Attached control plane record set ID, if any.",
+		},
+		"control_plane_fqdn": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Control plane FQDN, if present.",
+		},
+		"apps_load_balancer_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Attached apps load balancer ID, if any.",
+		},
+		"glueops_load_balancer_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Attached GlueOps load balancer ID, if any.",
+		},
+		"bastion_server_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Attached bastion server ID, if any.",
+		},
+		"docker_image": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Docker image.",
+		},
+		"docker_tag": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Docker tag.",
+		},
+		"created_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Creation timestamp.",
+		},
+		"updated_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last update timestamp.",
 		},
 	}
 }
@@ -184,73 +206,91 @@ func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	values := url.Values{}
 	if !config.Search.IsNull() && !config.Search.IsUnknown() {
 		values.Set("q", strings.TrimSpace(config.Search.ValueString()))
 	}
+	setQueryFilter(values, "status", config.Status)
+	setQueryFilter(values, "region", config.Region)
+	setQueryFilter(values, "cluster_provider", config.ClusterProvider)
 	query := values.Encode()
 
 	tflog.Info(ctx, "Listing Autoglue clusters", map[string]any{
 		"query": query,
 	})
 
-	var apiResp []cluster
-	if err := d.client.doJSON(ctx, http.MethodGet, "/clusters", query, nil, &apiResp); err != nil {
+	apiResp, err := listAll[cluster](ctx, d.client, "/clusters", values)
+	if err != nil {
 		resp.Diagnostics.AddError("Error listing clusters", err.Error())
 		return
 	}
 
 	config.Clusters = make([]clusterDataSourceItem, 0, len(apiResp))
 	for _, c := range apiResp {
-		item := clusterDataSourceItem{
-			ID:              types.StringValue(c.ID),
-			Name:            types.StringValue(c.Name),
-			ClusterProvider: types.StringValue(c.ClusterProvider),
-			Region:          types.StringValue(c.Region),
-			Status:          types.StringValue(c.Status),
-			LastError:       types.StringValue(c.LastError),
-			RandomToken:     types.StringValue(c.RandomToken),
-			CertificateKey:  types.StringValue(c.CertificateKey),
-			DockerImage:     types.StringValue(c.DockerImage),
-			DockerTag:       types.StringValue(c.DockerTag),
-			CreatedAt:       types.StringValue(c.CreatedAt),
-			UpdatedAt:       types.StringValue(c.UpdatedAt),
-		}
-
-		if c.CaptainDomain != nil {
-			item.CaptainDomainID = types.StringValue(c.CaptainDomain.ID)
-		} else {
-			item.CaptainDomainID = types.StringNull()
-		}
-		if c.ControlPlaneRecordSet != nil {
-			item.ControlPlaneRecordSetID = types.StringValue(c.ControlPlaneRecordSet.ID)
-		} else {
-			item.ControlPlaneRecordSetID = types.StringNull()
-		}
-		if c.ControlPlaneFQDN != nil {
-			item.ControlPlaneFQDN = types.StringValue(*c.ControlPlaneFQDN)
-		} else {
-			item.ControlPlaneFQDN = types.StringNull()
-		}
-		if c.AppsLoadBalancer != nil {
-			item.AppsLoadBalancerID = types.StringValue(c.AppsLoadBalancer.ID)
-		} else {
-			item.AppsLoadBalancerID = types.StringNull()
-		}
-		if c.GlueOpsLoadBalancer != nil {
-			item.GlueOpsLoadBalancerID = types.StringValue(c.GlueOpsLoadBalancer.ID)
-		} else {
-			item.GlueOpsLoadBalancerID = types.StringNull()
-		}
-		if c.BastionServer != nil {
-			item.BastionServerID = types.StringValue(c.BastionServer.ID)
-		} else {
-			item.BastionServerID = types.StringNull()
+		if !matchesExact(config.Status, c.Status) ||
+			!matchesExact(config.Region, c.Region) ||
+			!matchesExact(config.ClusterProvider, c.ClusterProvider) {
+			continue
 		}
 
-		config.Clusters = append(config.Clusters, item)
+		config.Clusters = append(config.Clusters, clusterDataSourceItemFromAPI(c))
 	}
 
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 }
+
+// clusterDataSourceItemFromAPI converts an API cluster into its data source representation.
+func clusterDataSourceItemFromAPI(c cluster) clusterDataSourceItem {
+	item := clusterDataSourceItem{
+		ID:              types.StringValue(c.ID),
+		Name:            types.StringValue(c.Name),
+		ClusterProvider: types.StringValue(c.ClusterProvider),
+		Region:          types.StringValue(c.Region),
+		Status:          types.StringValue(c.Status),
+		LastError:       types.StringValue(c.LastError),
+		RandomToken:     types.StringValue(c.RandomToken),
+		CertificateKey:  types.StringValue(c.CertificateKey),
+		DockerImage:     types.StringValue(c.DockerImage),
+		DockerTag:       types.StringValue(c.DockerTag),
+		CreatedAt:       types.StringValue(c.CreatedAt),
+		UpdatedAt:       types.StringValue(c.UpdatedAt),
+	}
+
+	if c.CaptainDomain != nil {
+		item.CaptainDomainID = types.StringValue(c.CaptainDomain.ID)
+	} else {
+		item.CaptainDomainID = types.StringNull()
+	}
+	if c.ControlPlaneRecordSet != nil {
+		item.ControlPlaneRecordSetID = types.StringValue(c.ControlPlaneRecordSet.ID)
+	} else {
+		item.ControlPlaneRecordSetID = types.StringNull()
+	}
+	if c.ControlPlaneFQDN != nil {
+		item.ControlPlaneFQDN = types.StringValue(*c.ControlPlaneFQDN)
+	} else {
+		item.ControlPlaneFQDN = types.StringNull()
+	}
+	if c.AppsLoadBalancer != nil {
+		item.AppsLoadBalancerID = types.StringValue(c.AppsLoadBalancer.ID)
+	} else {
+		item.AppsLoadBalancerID = types.StringNull()
+	}
+	if c.GlueOpsLoadBalancer != nil {
+		item.GlueOpsLoadBalancerID = types.StringValue(c.GlueOpsLoadBalancer.ID)
+	} else {
+		item.GlueOpsLoadBalancerID = types.StringNull()
+	}
+	if c.BastionServer != nil {
+		item.BastionServerID = types.StringValue(c.BastionServer.ID)
+	} else {
+		item.BastionServerID = types.StringNull()
+	}
+	return item
+}
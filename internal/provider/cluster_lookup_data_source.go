@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &clusterDataSource{}
+	_ datasource.DataSourceWithConfigure        = &clusterDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &clusterDataSource{}
+)
+
+type clusterDataSource struct {
+	client *autoglueClient
+}
+
+type clusterDataSourceModel struct {
+	clusterDataSourceItem
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func NewClusterDataSource() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+func (d *clusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+func (d *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Reads a single Autoglue cluster by ID or name.",
+		Attributes: lookupAttributes(clusterDataSourceAttributes(), map[string]string{
+			"id":   "Cluster ID to look up. Exactly one of `id` or `name` must be set.",
+			"name": "Cluster name to look up. Must match exactly one cluster.",
+		}),
+	}
+}
+
+func (d *clusterDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *clusterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config clusterDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found cluster
+	if id := filterValue(config.ID); id != "" {
+		tflog.Info(ctx, "Reading Autoglue cluster data source", map[string]any{"id": id})
+
+		apiPath := fmt.Sprintf("/clusters/%s", id)
+		if err := d.client.doJSON(ctx, http.MethodGet, apiPath, "", nil, &found); err != nil {
+			if isNotFound(err) {
+				err = fmt.Errorf("no cluster with id %q found", id)
+			}
+			resp.Diagnostics.AddError("Error reading cluster", err.Error())
+			return
+		}
+	} else {
+		name := filterValue(config.Name)
+		tflog.Info(ctx, "Looking up Autoglue cluster by name", map[string]any{"name": name})
+
+		items, err := listAll[cluster](ctx, d.client, "/clusters", url.Values{"q": {name}})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing clusters", err.Error())
+			return
+		}
+		found, err = findOne(items,
+			func(v cluster) bool { return matchesExact(config.Name, v.Name) },
+			func(v cluster) string { return v.ID },
+			"cluster", fmt.Sprintf("name %q", name))
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up cluster", err.Error())
+			return
+		}
+	}
+
+	state := clusterDataSourceModel{clusterDataSourceItemFromAPI(found), config.OrgID}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -31,6 +30,7 @@ type clusterMetadataResourceModel struct {
 	Key            types.String `tfsdk:"key"`
 	Value          types.String `tfsdk:"value"`
 	OrganizationID types.String `tfsdk:"organization_id"`
+	OrgID          types.String `tfsdk:"org_id"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
 }
@@ -69,6 +69,7 @@ func (r *clusterMetadataResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:    true,
 				Description: "Metadata value (case preserved).",
 			},
+			"org_id": orgIDResourceAttribute(),
 			"organization_id": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -115,6 +116,11 @@ func (r *clusterMetadataResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := plan.ClusterID.ValueString()
 	payload := createClusterMetadataPayload{
 		Key:   strings.ToLower(strings.TrimSpace(plan.Key.ValueString())),
@@ -152,6 +158,11 @@ func (r *clusterMetadataResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	id := state.ID.ValueString()
 	if id == "" {
@@ -199,6 +210,11 @@ func (r *clusterMetadataResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	id := state.ID.ValueString()
 	if id == "" {
@@ -245,6 +261,11 @@ func (r *clusterMetadataResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ClusterID.ValueString()
 	id := state.ID.ValueString()
 	if id == "" {
@@ -263,18 +284,8 @@ func (r *clusterMetadataResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *clusterMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster_metadata.example <cluster_id>/<metadata_id>
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			"Expected format: <cluster_id>/<metadata_id>",
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	// terraform import autoglue_cluster_metadata.example <cluster_id>/<metadata_id> or <org_id>/<cluster_id>/<metadata_id>
+	importStateCompositeID(ctx, "autoglue_cluster_metadata", req, resp)
 }
 
 func mapClusterMetadataToModel(m *clusterMetadataResourceModel, a *clusterMetadata) {
@@ -0,0 +1,30 @@
+package provider
+
+import "testing"
+
+func TestClusterMetadataResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+
+	md := h.resource("autoglue_cluster_metadata")
+	md.Apply(map[string]any{"cluster_id": clusterID, "key": "team", "value": "Platform"})
+	id := md.Attr("id")
+
+	md.Apply(map[string]any{"cluster_id": clusterID, "key": "team", "value": "SRE"})
+	if md.Attr("id") != id || api.clusterMetadata[id].Value != "SRE" {
+		t.Errorf("metadata not updated in place: %+v", api.clusterMetadata[id])
+	}
+
+	md.ImportVerify(clusterID + "/" + id)
+	md.ImportVerify(api.orgID + "/" + clusterID + "/" + id)
+	h.ImportExpectError("autoglue_cluster_metadata", id, "Invalid import ID")
+
+	md.Destroy()
+	if len(api.clusterMetadata) != 0 {
+		t.Errorf("expected metadata to be deleted")
+	}
+}
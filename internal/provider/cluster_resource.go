@@ -2,14 +2,19 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -47,6 +52,11 @@ type clusterResourceModel struct {
 
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	WaitForStatus types.String   `tfsdk:"wait_for_status"`
+	Timeouts      *timeoutsModel `tfsdk:"timeouts"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewClusterResource() resource.Resource {
@@ -60,7 +70,9 @@ func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataReque
 func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resourceschema.Schema{
 		Description: "Manages an Autoglue cluster (name, provider, region). " +
-			"Attachments such as domains, record sets, load balancers, bastion and node pools are managed via separate resources.",
+			"Attachments such as domains, record sets, load balancers, bastion and node pools are managed via separate resources. " +
+			"The API has no operations to reprovision a failed cluster or to rotate certificate_key and random_token; " +
+			"replace the resource instead.",
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.StringAttribute{
 				Computed:    true,
@@ -100,6 +112,16 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Cluster status (e.g. pre_pending, ready, failed).",
 			},
 
+			"wait_for_status": resourceschema.StringAttribute{
+				Optional: true,
+				Description: "If set, create and update wait until the cluster reports this status (`pre_pending`, `pending`, `provisioning` or `ready`), " +
+					"polling with backoff up to the matching `timeouts` value. The apply fails with `last_error` " +
+					"if the cluster lands on `failed` first. If omitted, the provider does not wait.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clusterWaitStatuses...),
+				},
+			},
+
 			"last_error": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Last validation/provisioning error, if any.",
@@ -155,6 +177,10 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:    true,
 				Description: "Last update timestamp (RFC3339).",
 			},
+			"org_id": orgIDResourceAttribute(),
+		},
+		Blocks: map[string]resourceschema.Block{
+			"timeouts": timeoutsBlock(),
 		},
 	}
 }
@@ -187,6 +213,11 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := createClusterPayload{
 		Name:            plan.Name.ValueString(),
 		ClusterProvider: plan.ClusterProvider.ValueString(),
@@ -203,6 +234,12 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		"docker_tag":       payload.DockerTag,
 	})
 
+	timeout, diags := plan.Timeouts.CreateTimeout(defaultClusterCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var apiResp cluster
 	if err := r.client.doJSON(ctx, http.MethodPost, "/clusters", "", payload, &apiResp); err != nil {
 		resp.Diagnostics.AddError("Error creating cluster", err.Error())
@@ -213,6 +250,15 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State is saved before waiting so a failed or timed-out wait leaves the
+	// cluster tracked (and tainted) instead of orphaned.
+	r.waitForStatus(ctx, &plan, timeout, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -228,6 +274,11 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.State.RemoveResource(ctx)
@@ -274,12 +325,23 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Cluster ID is required in state to update cluster.")
 		return
 	}
 
+	timeout, diags := plan.Timeouts.UpdateTimeout(defaultClusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var payload updateClusterPayload
 	if !plan.Name.Equal(state.Name) {
 		v := plan.Name.ValueString()
@@ -309,7 +371,12 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		payload.Region == nil &&
 		payload.DockerImage == nil &&
 		payload.DockerTag == nil {
+		// Only wait_for_status or timeouts changed; nothing to send to the API.
 		tflog.Info(ctx, "No changes detected for Autoglue cluster", map[string]any{"id": id})
+		syncClusterModelComputed(&plan, &state)
+		r.waitForStatus(ctx, &plan, timeout, &resp.Diagnostics)
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
@@ -331,6 +398,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	syncClusterFromAPI(&plan, &apiResp)
+	r.waitForStatus(ctx, &plan, timeout, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -349,6 +417,11 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		return
@@ -357,17 +430,76 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	path := fmt.Sprintf("/clusters/%s", id)
 	tflog.Info(ctx, "Deleting Autoglue cluster", map[string]any{"id": id})
 
+	timeout, diags := state.Timeouts.DeleteTimeout(defaultClusterDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.doJSON(ctx, http.MethodDelete, path, "", nil, nil); err != nil {
 		resp.Diagnostics.AddError("Error deleting cluster", err.Error())
 		return
 	}
 
+	if err := r.client.waitForClusterDeleted(ctx, id, timeout); err != nil {
+		resp.Diagnostics.AddError("Error waiting for cluster deletion", err.Error())
+		return
+	}
+
 	resp.State.RemoveResource(ctx)
 }
 
 func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_cluster.example <cluster_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_cluster.example <cluster_id> or <org_id>/<cluster_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
+}
+
+// waitForStatus blocks until the cluster reaches model.WaitForStatus, if set,
+// refreshing model from the last poll and reporting failures to diags.
+func (r *clusterResource) waitForStatus(ctx context.Context, model *clusterResourceModel, timeout time.Duration, diags *diag.Diagnostics) {
+	target := model.WaitForStatus.ValueString()
+	if target == "" || model.Status.ValueString() == target {
+		return
+	}
+
+	id := model.ID.ValueString()
+	tflog.Info(ctx, "Waiting for Autoglue cluster status", map[string]any{
+		"id":      id,
+		"target":  target,
+		"timeout": timeout.String(),
+	})
+
+	last, err := r.client.waitForClusterStatus(ctx, id, target, timeout)
+	if last != nil {
+		syncClusterFromAPI(model, last)
+	}
+	switch {
+	case errors.Is(err, errClusterFailed):
+		detail := fmt.Sprintf("Cluster %s entered status %q while waiting for %q.", id, clusterStatusFailed, target)
+		if last != nil && last.LastError != "" {
+			detail += "\n\nlast_error: " + last.LastError
+		}
+		diags.AddAttributeError(path.Root("status"), "Cluster provisioning failed", detail)
+	case err != nil:
+		diags.AddError("Error waiting for cluster status", err.Error())
+	}
+}
+
+// syncClusterModelComputed copies server-populated attributes from src to dst.
+func syncClusterModelComputed(dst, src *clusterResourceModel) {
+	dst.ID = src.ID
+	dst.Status = src.Status
+	dst.LastError = src.LastError
+	dst.RandomToken = src.RandomToken
+	dst.CertificateKey = src.CertificateKey
+	dst.CaptainDomainID = src.CaptainDomainID
+	dst.ControlPlaneRecordSetID = src.ControlPlaneRecordSetID
+	dst.ControlPlaneFQDN = src.ControlPlaneFQDN
+	dst.AppsLoadBalancerID = src.AppsLoadBalancerID
+	dst.GlueOpsLoadBalancerID = src.GlueOpsLoadBalancerID
+	dst.BastionServerID = src.BastionServerID
+	dst.CreatedAt = src.CreatedAt
+	dst.UpdatedAt = src.UpdatedAt
 }
 
 func syncClusterFromAPI(state *clusterResourceModel, api *cluster) {
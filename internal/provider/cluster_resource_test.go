@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func clusterConfig(name string) map[string]any {
+	return map[string]any{
+		"name":             name,
+		"cluster_provider": "aws",
+		"region":           "us-east-1",
+		"docker_image":     "ghcr.io/glueops/cluster",
+		"docker_tag":       "v1.0.0",
+	}
+}
+
+// fastClusterPolling shortens cluster wait intervals for the duration of a test.
+func fastClusterPolling(t *testing.T) {
+	t.Helper()
+	initial, max := clusterPollInitialInterval, clusterPollMaxInterval
+	clusterPollInitialInterval, clusterPollMaxInterval = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() {
+		clusterPollInitialInterval, clusterPollMaxInterval = initial, max
+	})
+}
+
+func TestClusterResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	config := clusterConfig("prod")
+	cluster.Apply(config)
+	id := cluster.Attr("id")
+
+	if got := cluster.Attr("status"); got != "pre_pending" {
+		t.Errorf("status = %q, want pre_pending", got)
+	}
+	if cluster.Attr("random_token") == "" || cluster.Attr("certificate_key") == "" {
+		t.Errorf("generated secrets not populated")
+	}
+
+	config["docker_tag"] = "v1.1.0"
+	cluster.Apply(config)
+	if cluster.Attr("id") != id || api.clusters[id].DockerTag != "v1.1.0" {
+		t.Errorf("cluster not updated in place: %+v", api.clusters[id])
+	}
+
+	cluster.ImportVerify(id)
+
+	list := h.ReadDataSource("autoglue_clusters", map[string]any{"search": "pro"})
+	if got := len(objectElems(t, list["clusters"])); got != 1 {
+		t.Errorf("clusters data source returned %d, want 1", got)
+	}
+
+	byName := h.ReadDataSource("autoglue_cluster", map[string]any{"name": "prod"})
+	if got := valueString(t, byName["id"]); got != id {
+		t.Errorf("cluster data source by name returned id %q, want %q", got, id)
+	}
+	h.ReadDataSourceExpectError("autoglue_cluster", map[string]any{"name": "pro"}, `no cluster with name "pro" found`)
+
+	cluster.Destroy()
+	if len(api.clusters) != 0 {
+		t.Errorf("expected cluster to be deleted")
+	}
+}
+
+func TestClusterResource_WaitForStatus(t *testing.T) {
+	fastClusterPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	api.clusterStatuses = []string{"pre_pending", "pending", "provisioning", "ready"}
+
+	cluster := h.resource("autoglue_cluster")
+	config := clusterConfig("prod")
+	config["wait_for_status"] = "ready"
+	config["timeouts"] = map[string]any{"create": "1m"}
+	cluster.Apply(config)
+
+	if got := cluster.Attr("status"); got != "ready" {
+		t.Fatalf("status = %q, want ready", got)
+	}
+}
+
+func TestClusterResource_WaitForStatusInvalid(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	config := clusterConfig("prod")
+	config["wait_for_status"] = "running"
+	h.resource("autoglue_cluster").ApplyExpectError(config, "value must be one of")
+	if len(api.clusters) != 0 {
+		t.Errorf("cluster created despite invalid wait_for_status")
+	}
+}
+
+func TestClusterResource_WaitForStatusFailed(t *testing.T) {
+	fastClusterPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	api.clusterStatuses = []string{"pending", "failed"}
+	api.clusterFailedError = "bastion unreachable"
+
+	cluster := h.resource("autoglue_cluster")
+	config := clusterConfig("prod")
+	config["wait_for_status"] = "ready"
+
+	cluster.ApplyExpectError(config, "last_error: bastion unreachable")
+	if cluster.state.IsNull() {
+		t.Fatalf("expected the failed cluster to be kept in state")
+	}
+	if got := cluster.Attr("status"); got != "failed" {
+		t.Errorf("status = %q, want failed", got)
+	}
+	if got := cluster.Attr("last_error"); got != "bastion unreachable" {
+		t.Errorf("last_error = %q, want bastion unreachable", got)
+	}
+}
+
+func TestClusterResource_WaitForStatusTimeout(t *testing.T) {
+	fastClusterPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	api.clusterStatuses = []string{"pending"}
+
+	cluster := h.resource("autoglue_cluster")
+	config := clusterConfig("prod")
+	config["wait_for_status"] = "ready"
+	config["timeouts"] = map[string]any{"create": "50ms"}
+	cluster.ApplyExpectError(config, "timed out after 50ms")
+}
+
+func TestClusterResource_InvalidTimeout(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	config := clusterConfig("prod")
+	config["timeouts"] = map[string]any{"create": "soon"}
+	cluster.ApplyExpectError(config, "Invalid duration")
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const serverStatusReady = "ready"
+
+var (
+	_ datasource.DataSource              = &clusterStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterStatusDataSource{}
+)
+
+type clusterStatusDataSource struct {
+	client *autoglueClient
+}
+
+type clusterStatusDataSourceModel struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+	Status    types.String `tfsdk:"status"`
+	LastError types.String `tfsdk:"last_error"`
+
+	Ready           types.Bool `tfsdk:"ready"`
+	NotReadyReasons types.List `tfsdk:"not_ready_reasons"`
+
+	ControlPlaneFQDN   types.String `tfsdk:"control_plane_fqdn"`
+	ControlPlaneReady  types.Bool   `tfsdk:"control_plane_ready"`
+	DNSRecordStatus    types.String `tfsdk:"dns_record_status"`
+	DNSRecordLastError types.String `tfsdk:"dns_record_last_error"`
+
+	AppsLoadBalancerAttached    types.Bool `tfsdk:"apps_load_balancer_attached"`
+	GlueOpsLoadBalancerAttached types.Bool `tfsdk:"glueops_load_balancer_attached"`
+
+	NodePools []clusterStatusNodePoolModel `tfsdk:"node_pools"`
+
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+type clusterStatusNodePoolModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Ready            types.Bool   `tfsdk:"ready"`
+	ServerCount      types.Int64  `tfsdk:"server_count"`
+	ReadyServerCount types.Int64  `tfsdk:"ready_server_count"`
+	ServerStatuses   types.Map    `tfsdk:"server_statuses"`
+}
+
+func NewClusterStatusDataSource() datasource.DataSource {
+	return &clusterStatusDataSource{}
+}
+
+func (d *clusterStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_status"
+}
+
+func (d *clusterStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Reports the readiness of each component of a cluster: control plane, node pools, DNS and load balancers. " +
+			"A cluster that isn't ready is not an error, so the data source can be used in a `check` block, " +
+			"e.g. asserting `ready` with `join(\", \", not_ready_reasons)` as the error message.",
+		Attributes: map[string]dsschema.Attribute{
+			"cluster_id": dsschema.StringAttribute{
+				Required:    true,
+				Description: "Cluster ID.",
+			},
+			"name": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Cluster name.",
+			},
+			"status": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Cluster status.",
+			},
+			"last_error": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Last error message.",
+			},
+			"ready": dsschema.BoolAttribute{
+				Computed: true,
+				Description: "True if the cluster is `ready`, `control_plane_ready` is true, it has at least one node pool, " +
+					"every node pool is ready and both load balancers are attached.",
+			},
+			"not_ready_reasons": dsschema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Why the cluster isn't ready, one entry per component. Empty if `ready` is true.",
+			},
+			"control_plane_fqdn": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Control plane FQDN, if present.",
+			},
+			"control_plane_ready": dsschema.BoolAttribute{
+				Computed: true,
+				Description: "True if the cluster is `ready` and its control plane FQDN is published by a `ready` record set. " +
+					"Derived from the API; the provider doesn't connect to the control plane itself.",
+			},
+			"dns_record_status": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the control plane record set. Null if none is attached.",
+			},
+			"dns_record_last_error": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Last error of the control plane record set. Null if none is attached.",
+			},
+			"apps_load_balancer_attached": dsschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether an apps load balancer is attached.",
+			},
+			"glueops_load_balancer_attached": dsschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a GlueOps load balancer is attached.",
+			},
+			"node_pools": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Node pools attached to the cluster.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Node pool ID.",
+						},
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Node pool name.",
+						},
+						"ready": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "True if the node pool has at least one server and all of its servers are `ready`.",
+						},
+						"server_count": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of servers in the node pool.",
+						},
+						"ready_server_count": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of servers in the node pool with status `ready`.",
+						},
+						"server_statuses": dsschema.MapAttribute{
+							ElementType: types.Int64Type,
+							Computed:    true,
+							Description: "Number of servers in the node pool by status.",
+						},
+					},
+				},
+			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *clusterStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *clusterStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config clusterStatusDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := config.ClusterID.ValueString()
+	tflog.Info(ctx, "Reading Autoglue cluster status", map[string]any{"cluster_id": clusterID})
+
+	var c cluster
+	if err := d.client.doJSON(ctx, http.MethodGet, fmt.Sprintf("/clusters/%s", clusterID), "", nil, &c); err != nil {
+		if isNotFound(err) {
+			err = fmt.Errorf("no cluster with id %q found", clusterID)
+		}
+		resp.Diagnostics.AddError("Error reading cluster", err.Error())
+		return
+	}
+
+	state := clusterStatusDataSourceModel{
+		ClusterID:                   config.ClusterID,
+		Name:                        types.StringValue(c.Name),
+		Status:                      types.StringValue(c.Status),
+		LastError:                   types.StringValue(c.LastError),
+		ControlPlaneFQDN:            types.StringNull(),
+		DNSRecordStatus:             types.StringNull(),
+		DNSRecordLastError:          types.StringNull(),
+		AppsLoadBalancerAttached:    types.BoolValue(c.AppsLoadBalancer != nil),
+		GlueOpsLoadBalancerAttached: types.BoolValue(c.GlueOpsLoadBalancer != nil),
+		NodePools:                   []clusterStatusNodePoolModel{},
+		OrgID:                       config.OrgID,
+	}
+	reasons := []string{}
+
+	if c.Status != clusterStatusReady {
+		reason := fmt.Sprintf("cluster status is %q", c.Status)
+		if c.LastError != "" {
+			reason += ": " + c.LastError
+		}
+		reasons = append(reasons, reason)
+	}
+
+	dnsReady := false
+	if c.ControlPlaneRecordSet == nil {
+		reasons = append(reasons, "no control plane record set is attached")
+	} else {
+		var rs recordSet
+		apiPath := fmt.Sprintf("/dns/records/%s", c.ControlPlaneRecordSet.ID)
+		if err := d.client.doJSON(ctx, http.MethodGet, apiPath, "", nil, &rs); err != nil {
+			if !isNotFound(err) {
+				resp.Diagnostics.AddError("Error reading control plane record set", err.Error())
+				return
+			}
+			reasons = append(reasons, fmt.Sprintf("control plane record set %s not found", c.ControlPlaneRecordSet.ID))
+		} else {
+			state.DNSRecordStatus = types.StringValue(rs.Status)
+			state.DNSRecordLastError = types.StringValue(rs.LastError)
+			dnsReady = rs.Status == recordSetStatusReady
+			if !dnsReady {
+				reason := fmt.Sprintf("control plane record set %s status is %q", rs.Name, rs.Status)
+				if rs.LastError != "" {
+					reason += ": " + rs.LastError
+				}
+				reasons = append(reasons, reason)
+			}
+		}
+	}
+
+	if c.ControlPlaneFQDN != nil {
+		state.ControlPlaneFQDN = types.StringValue(*c.ControlPlaneFQDN)
+	}
+	state.ControlPlaneReady = types.BoolValue(c.Status == clusterStatusReady && dnsReady && c.ControlPlaneFQDN != nil)
+
+	if len(c.NodePools) == 0 {
+		reasons = append(reasons, "no node pools are attached")
+	}
+	for _, np := range c.NodePools {
+		pool, reason, err := d.readNodePoolStatus(ctx, np.ID, np.Name, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading node pool servers", err.Error())
+			return
+		}
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+		state.NodePools = append(state.NodePools, pool)
+	}
+
+	if c.AppsLoadBalancer == nil {
+		reasons = append(reasons, "no apps load balancer is attached")
+	}
+	if c.GlueOpsLoadBalancer == nil {
+		reasons = append(reasons, "no GlueOps load balancer is attached")
+	}
+
+	state.Ready = types.BoolValue(len(reasons) == 0)
+	state.NotReadyReasons, diags = types.ListValueFrom(ctx, types.StringType, reasons)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// readNodePoolStatus counts the servers of node pool id by status. It returns
+// why the pool isn't ready, or "" if it is.
+func (d *clusterStatusDataSource) readNodePoolStatus(ctx context.Context, id, name string, diags *diag.Diagnostics) (clusterStatusNodePoolModel, string, error) {
+	var servers []server
+	if err := d.client.doJSON(ctx, http.MethodGet, fmt.Sprintf("/node-pools/%s/servers", id), "", nil, &servers); err != nil {
+		return clusterStatusNodePoolModel{}, "", err
+	}
+
+	statuses := map[string]int64{}
+	var readyCount int64
+	for _, s := range servers {
+		statuses[s.Status]++
+		if s.Status == serverStatusReady {
+			readyCount++
+		}
+	}
+	statusesVal, mapDiags := types.MapValueFrom(ctx, types.Int64Type, statuses)
+	diags.Append(mapDiags...)
+
+	total := int64(len(servers))
+	ready := total > 0 && readyCount == total
+
+	var reason string
+	switch {
+	case total == 0:
+		reason = fmt.Sprintf("node pool %s has no servers", name)
+	case !ready:
+		reason = fmt.Sprintf("node pool %s has %d of %d servers ready", name, readyCount, total)
+	}
+
+	return clusterStatusNodePoolModel{
+		ID:               types.StringValue(id),
+		Name:             types.StringValue(name),
+		Ready:            types.BoolValue(ready),
+		ServerCount:      types.Int64Value(total),
+		ReadyServerCount: types.Int64Value(readyCount),
+		ServerStatuses:   statusesVal,
+	}, reason, nil
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterStatusDataSource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cluster := h.resource("autoglue_cluster")
+	cluster.Apply(clusterConfig("prod"))
+	clusterID := cluster.Attr("id")
+
+	status := h.ReadDataSource("autoglue_cluster_status", map[string]any{"cluster_id": clusterID})
+	if got := valueString(t, status["ready"]); got != "false" {
+		t.Errorf("bare cluster reported ready=%s", got)
+	}
+	if !status["dns_record_status"].IsNull() {
+		t.Errorf("dns_record_status = %s, want null without a record set", valueString(t, status["dns_record_status"]))
+	}
+	wantReasons := []string{
+		`cluster status is "pre_pending"`,
+		"no control plane record set is attached",
+		"no node pools are attached",
+		"no apps load balancer is attached",
+		"no GlueOps load balancer is attached",
+	}
+	if got := listStrings(t, status["not_ready_reasons"]); !reflect.DeepEqual(got, wantReasons) {
+		t.Errorf("not_ready_reasons = %q, want %q", got, wantReasons)
+	}
+
+	cred := applyTestCredential(t, h)
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{"domain_name": "example.com", "credential_id": cred.Attr("id")})
+	rs := h.resource("autoglue_record_set")
+	rs.Apply(map[string]any{
+		"domain_id": domain.Attr("id"),
+		"name":      "cp",
+		"type":      "A",
+		"values":    []string{"203.0.113.1"},
+	})
+	appsLB := h.resource("autoglue_load_balancer")
+	appsLB.Apply(map[string]any{"name": "apps", "kind": "public"})
+	glueopsLB := h.resource("autoglue_load_balancer")
+	glueopsLB.Apply(map[string]any{"name": "cp", "kind": "glueops"})
+	np := h.resource("autoglue_node_pool")
+	np.Apply(map[string]any{"name": "workers", "role": "worker"})
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "nodes"})
+	var servers []string
+	for _, host := range []string{"w1", "w2"} {
+		s := h.resource("autoglue_server")
+		s.Apply(map[string]any{"hostname": host, "ssh_key_id": key.Attr("id"), "ssh_user": "ubuntu"})
+		servers = append(servers, s.Attr("id"))
+	}
+	h.resource("autoglue_node_pool_servers").Apply(map[string]any{"node_pool_id": np.Attr("id"), "server_ids": servers})
+	h.resource("autoglue_cluster_attachments").Apply(map[string]any{
+		"cluster_id":               clusterID,
+		"captain_domain":           map[string]any{"domain_id": domain.Attr("id")},
+		"control_plane_record_set": map[string]any{"record_set_id": rs.Attr("id")},
+		"apps_load_balancer":       map[string]any{"load_balancer_id": appsLB.Attr("id")},
+		"glueops_load_balancer":    map[string]any{"load_balancer_id": glueopsLB.Attr("id")},
+		"node_pools":               map[string]any{"node_pool_ids": []string{np.Attr("id")}},
+	})
+
+	api.clusters[clusterID].Status = "ready"
+	api.servers[servers[0]].Status = "ready"
+	api.recordSets[rs.Attr("id")].Status = "pending"
+
+	status = h.ReadDataSource("autoglue_cluster_status", map[string]any{"cluster_id": clusterID})
+	if got := valueString(t, status["control_plane_ready"]); got != "false" {
+		t.Errorf("control_plane_ready = %s with a pending record set, want false", got)
+	}
+	if got := valueString(t, status["dns_record_status"]); got != "pending" {
+		t.Errorf("dns_record_status = %q, want pending", got)
+	}
+	pools := objectElems(t, status["node_pools"])
+	if len(pools) != 1 {
+		t.Fatalf("node_pools = %d entries, want 1", len(pools))
+	}
+	if got := valueString(t, pools[0]["name"]); got != "workers" {
+		t.Errorf("node_pools[0].name = %q, want workers", got)
+	}
+	if total, ready := valueString(t, pools[0]["server_count"]), valueString(t, pools[0]["ready_server_count"]); total != "2" || ready != "1" {
+		t.Errorf("node_pools[0] server_count=%s ready_server_count=%s, want 2 and 1", total, ready)
+	}
+	byStatus := objectAttrs(t, pools[0]["server_statuses"])
+	if valueString(t, byStatus["ready"]) != "1" || valueString(t, byStatus["pending"]) != "1" || len(byStatus) != 2 {
+		t.Errorf("node_pools[0].server_statuses = %v, want ready=1 pending=1", byStatus)
+	}
+	wantReasons = []string{
+		`control plane record set cp status is "pending"`,
+		"node pool workers has 1 of 2 servers ready",
+	}
+	if got := listStrings(t, status["not_ready_reasons"]); !reflect.DeepEqual(got, wantReasons) {
+		t.Errorf("not_ready_reasons = %q, want %q", got, wantReasons)
+	}
+
+	api.servers[servers[1]].Status = "ready"
+	api.recordSets[rs.Attr("id")].Status = "ready"
+	status = h.ReadDataSource("autoglue_cluster_status", map[string]any{"cluster_id": clusterID})
+	if got := valueString(t, status["ready"]); got != "true" {
+		t.Errorf("ready = %s, want true; not_ready_reasons = %q", got, listStrings(t, status["not_ready_reasons"]))
+	}
+	if got := valueString(t, status["control_plane_ready"]); got != "true" {
+		t.Errorf("control_plane_ready = %s, want true", got)
+	}
+	if got := valueString(t, status["control_plane_fqdn"]); got != "cp.example.com" {
+		t.Errorf("control_plane_fqdn = %q, want cp.example.com", got)
+	}
+	if got := listStrings(t, status["not_ready_reasons"]); len(got) != 0 {
+		t.Errorf("not_ready_reasons = %q, want none", got)
+	}
+
+	h.ReadDataSourceExpectError("autoglue_cluster_status", map[string]any{"cluster_id": "missing"}, `no cluster with id "missing" found`)
+}
+
+// listStrings returns the string elements of a list value, in order.
+func listStrings(t *testing.T, v tftypes.Value) []string {
+	t.Helper()
+	var elems []tftypes.Value
+	if err := v.As(&elems); err != nil {
+		t.Fatalf("decoding list: %s", err)
+	}
+	out := make([]string, 0, len(elems))
+	for _, e := range elems {
+		out = append(out, valueString(t, e))
+	}
+	return out
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	clusterStatusPrePending   = "pre_pending"
+	clusterStatusPending      = "pending"
+	clusterStatusProvisioning = "provisioning"
+	clusterStatusReady        = "ready"
+	clusterStatusFailed       = "failed"
+
+	defaultClusterCreateTimeout = 30 * time.Minute
+	defaultClusterUpdateTimeout = 30 * time.Minute
+	defaultClusterDeleteTimeout = 20 * time.Minute
+)
+
+// clusterWaitStatuses are the statuses wait_for_status accepts. "failed" is
+// left out because waits stop with an error there.
+var clusterWaitStatuses = []string{
+	clusterStatusPrePending,
+	clusterStatusPending,
+	clusterStatusProvisioning,
+	clusterStatusReady,
+}
+
+// Poll intervals for cluster waits. Variables so tests can shorten them.
+var (
+	clusterPollInitialInterval = 2 * time.Second
+	clusterPollMaxInterval     = 30 * time.Second
+)
+
+// errClusterFailed is returned by waitForClusterStatus when the cluster lands
+// on "failed" before reaching the target status.
+var errClusterFailed = errors.New("cluster provisioning failed")
+
+// waitForClusterStatus polls GET /clusters/{id} until the cluster reports
+// target, reaches a terminal status, or timeout elapses. The last observed
+// cluster is returned in every case so callers can persist it.
+func (c *autoglueClient) waitForClusterStatus(ctx context.Context, id, target string, timeout time.Duration) (*cluster, error) {
+	ctx, cancel := context.WithTimeout(withFreshReads(ctx), timeout)
+	defer cancel()
+
+	path := fmt.Sprintf("/clusters/%s", id)
+	interval := clusterPollInitialInterval
+
+	var last *cluster
+	for {
+		var apiResp cluster
+		if err := c.doJSON(ctx, http.MethodGet, path, "", nil, &apiResp); err != nil {
+			if ctx.Err() != nil {
+				return last, clusterWaitTimeoutError(id, target, timeout, last)
+			}
+			return last, err
+		}
+		last = &apiResp
+
+		tflog.Debug(ctx, "Polled Autoglue cluster status", map[string]any{
+			"id":     id,
+			"status": apiResp.Status,
+			"target": target,
+		})
+
+		switch apiResp.Status {
+		case target:
+			return last, nil
+		case clusterStatusFailed:
+			return last, errClusterFailed
+		case clusterStatusReady:
+			// Ready is terminal and past every intermediate status, so an
+			// earlier target has been passed rather than missed.
+			return last, nil
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return last, clusterWaitTimeoutError(id, target, timeout, last)
+		}
+		interval = min(interval*2, clusterPollMaxInterval)
+	}
+}
+
+// waitForClusterDeleted polls GET /clusters/{id} until it returns 404 or timeout elapses.
+func (c *autoglueClient) waitForClusterDeleted(ctx context.Context, id string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(withFreshReads(ctx), timeout)
+	defer cancel()
+
+	path := fmt.Sprintf("/clusters/%s", id)
+	interval := clusterPollInitialInterval
+
+	for {
+		var apiResp cluster
+		err := c.doJSON(ctx, http.MethodGet, path, "", nil, &apiResp)
+		if isNotFound(err) {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		tflog.Debug(ctx, "Waiting for Autoglue cluster deletion", map[string]any{
+			"id":     id,
+			"status": apiResp.Status,
+		})
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return fmt.Errorf("timed out after %s waiting for cluster %s to be deleted", timeout, id)
+		}
+		interval = min(interval*2, clusterPollMaxInterval)
+	}
+}
+
+func clusterWaitTimeoutError(id, target string, timeout time.Duration, last *cluster) error {
+	status := "unknown"
+	if last != nil {
+		status = last.Status
+	}
+	return fmt.Errorf("timed out after %s waiting for cluster %s to reach %q (last status %q)", timeout, id, target, status)
+}
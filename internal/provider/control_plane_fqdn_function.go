@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &controlPlaneFQDNFunction{}
+
+type controlPlaneFQDNFunction struct{}
+
+func NewControlPlaneFQDNFunction() function.Function {
+	return &controlPlaneFQDNFunction{}
+}
+
+func (f *controlPlaneFQDNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "control_plane_fqdn"
+}
+
+func (f *controlPlaneFQDNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns a cluster's control plane FQDN the way the API derives it.",
+		Description: "Returns the fully qualified domain name the API reports as a cluster's `control_plane_fqdn` " +
+			"when its control plane record set has the given name in the given domain. " +
+			"The result is lowercase without a trailing dot. `record_name` may be relative (e.g. `api`), " +
+			"`@` for the domain apex, or already fully qualified.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "record_name",
+				Description: "Name of the control plane record set.",
+			},
+			function.StringParameter{
+				Name:        "domain_name",
+				Description: "Name of the domain the record set belongs to.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *controlPlaneFQDNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var recordName, domainName string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &recordName, &domainName))
+	if resp.Error != nil {
+		return
+	}
+
+	if strings.Trim(strings.TrimSpace(domainName), ".") == "" {
+		resp.Error = function.NewArgumentFuncError(1, "domain_name must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, controlPlaneFQDN(recordName, domainName)))
+}
+
+// controlPlaneFQDN joins a record name and its domain name the way the API
+// builds control_plane_fqdn.
+func controlPlaneFQDN(recordName, domainName string) string {
+	domain := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domainName)), ".")
+	name := relativeRecordName(recordName, domain)
+	if name == "@" {
+		return domain
+	}
+	return name + "." + domain
+}
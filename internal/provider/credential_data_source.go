@@ -35,6 +35,8 @@ type credentialDataSourceModel struct {
 
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewCredentialDataSource() datasource.DataSource {
@@ -98,6 +100,7 @@ func (d *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:    true,
 				Description: "Last update timestamp.",
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -132,6 +135,11 @@ func (d *credentialDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := config.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "id must be set to read a credential.")
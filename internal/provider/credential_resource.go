@@ -18,9 +18,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &credentialResource{}
-	_ resource.ResourceWithConfigure   = &credentialResource{}
-	_ resource.ResourceWithImportState = &credentialResource{}
+	_ resource.Resource                 = &credentialResource{}
+	_ resource.ResourceWithConfigure    = &credentialResource{}
+	_ resource.ResourceWithImportState  = &credentialResource{}
+	_ resource.ResourceWithUpgradeState = &credentialResource{}
 )
 
 type credentialResource struct {
@@ -34,15 +35,18 @@ type credentialResourceModel struct {
 	Kind               types.String `tfsdk:"kind"`
 	SchemaVersion      types.Int64  `tfsdk:"schema_version"`
 
-	Scope        types.Map    `tfsdk:"scope"`
-	ScopeKind    types.String `tfsdk:"scope_kind"`
-	ScopeVersion types.Int64  `tfsdk:"scope_version"`
-	Secret       types.Map    `tfsdk:"secret"`
-	AccountID    types.String `tfsdk:"account_id"`
-	Region       types.String `tfsdk:"region"`
+	Scope         types.Map    `tfsdk:"scope"`
+	ScopeKind     types.String `tfsdk:"scope_kind"`
+	ScopeVersion  types.Int64  `tfsdk:"scope_version"`
+	Secret        types.Map    `tfsdk:"secret"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+	AccountID     types.String `tfsdk:"account_id"`
+	Region        types.String `tfsdk:"region"`
 
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewCredentialResource() resource.Resource {
@@ -56,6 +60,7 @@ func (r *credentialResource) Metadata(_ context.Context, req resource.MetadataRe
 func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resourceschema.Schema{
 		Description: "Manages an Autoglue credential (for example, a cloud account credential).",
+		Version:     1,
 		Attributes: map[string]resourceschema.Attribute{
 			"id": resourceschema.StringAttribute{
 				Computed:    true,
@@ -124,7 +129,15 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType: types.StringType,
 				Required:    true,
 				Sensitive:   true,
-				Description: "Credential secret payload as a map of key/value pairs. WARNING: values are stored in Terraform state.",
+				WriteOnly:   true,
+				Description: "Credential secret payload as a map of key/value pairs. Write-only (requires Terraform 1.11+): " +
+					"sent when the credential is created and whenever `secret_version` changes, never stored in plan or state.",
+			},
+
+			"secret_version": resourceschema.Int64Attribute{
+				Optional: true,
+				Description: "Arbitrary version number for `secret`. Terraform can't detect changes to a write-only value, " +
+					"so change this (e.g. increment it) to send an updated `secret` to the API.",
 			},
 
 			"account_id": resourceschema.StringAttribute{
@@ -146,6 +159,7 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Description: "Last update timestamp.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -173,14 +187,22 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	var plan credentialResourceModel
+	var plan, config credentialResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	scope, secret, d := credentialMapsFromPlan(ctx, plan.Scope, plan.Secret)
+	// secret is write-only, so it's only available from config.
+	scope, secret, d := credentialMapsFromPlan(ctx, plan.Scope, config.Secret)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -212,7 +234,8 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	var state credentialResourceModel
-	mapCredentialToState(ctx, &state, &apiResp, scope, secret, &resp.Diagnostics)
+	state.SecretVersion = plan.SecretVersion
+	mapCredentialToState(ctx, &state, &apiResp, scope, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -235,6 +258,11 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Credential ID is required in state to read credential.")
@@ -255,14 +283,13 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Preserve the secret from existing state – API never returns it.
-	scopeMap, secretMap, d := credentialMapsFromState(ctx, &state)
+	scopeMap, _, d := credentialMapsFromPlan(ctx, state.Scope, types.MapNull(types.StringType))
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	mapCredentialToState(ctx, &state, &apiResp, scopeMap, secretMap, &resp.Diagnostics)
+	mapCredentialToState(ctx, &state, &apiResp, scopeMap, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -277,11 +304,13 @@ func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	var plan credentialResourceModel
+	var plan, config credentialResourceModel
 	var state credentialResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -292,13 +321,24 @@ func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Credential ID is required in state to update credential.")
 		return
 	}
 
-	scope, secret, d := credentialMapsFromPlan(ctx, plan.Scope, plan.Secret)
+	// The secret is only sent again when secret_version changes; otherwise it
+	// stays empty and is omitted from the request.
+	secretAttr := types.MapNull(types.StringType)
+	if !plan.SecretVersion.Equal(state.SecretVersion) {
+		secretAttr = config.Secret
+	}
+	scope, secret, d := credentialMapsFromPlan(ctx, plan.Scope, secretAttr)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -323,6 +363,7 @@ func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		"region":        payload.Region,
 		"scope_kind":    payload.ScopeKind,
 		"scope_version": payload.ScopeVersion,
+		"secret_sent":   len(payload.Secret) > 0,
 	})
 
 	var apiResp credential
@@ -331,7 +372,8 @@ func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	mapCredentialToState(ctx, &state, &apiResp, scope, secret, &resp.Diagnostics)
+	state.SecretVersion = plan.SecretVersion
+	mapCredentialToState(ctx, &state, &apiResp, scope, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -353,6 +395,11 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		return
@@ -371,15 +418,23 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_credential.example <credential_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_credential.example <credential_id> or <org_id>/<credential_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
+}
+
+func (r *credentialResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// v0 stored secret in state.
+		0: nullAttributesUpgrader("secret", "secret_version"),
+	}
 }
 
 // --- helpers ---
 
 func credentialMapsFromPlan(ctx context.Context, scope types.Map, secret types.Map) (map[string]string, map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	scopeOut := map[string]string{}
+	// scopeOut stays nil when scope is unset so it maps back to null.
+	var scopeOut map[string]string
 	secretOut := map[string]string{}
 
 	if !scope.IsNull() && !scope.IsUnknown() {
@@ -393,16 +448,11 @@ func credentialMapsFromPlan(ctx context.Context, scope types.Map, secret types.M
 	return scopeOut, secretOut, diags
 }
 
-func credentialMapsFromState(ctx context.Context, state *credentialResourceModel) (map[string]string, map[string]string, diag.Diagnostics) {
-	return credentialMapsFromPlan(ctx, state.Scope, state.Secret)
-}
-
 func mapCredentialToState(
 	ctx context.Context,
 	state *credentialResourceModel,
 	api *credential,
 	scope map[string]string,
-	secret map[string]string,
 	diags *diag.Diagnostics,
 ) {
 	state.ID = types.StringValue(api.ID)
@@ -423,22 +473,21 @@ func mapCredentialToState(
 	state.CreatedAt = types.StringValue(api.CreatedAt)
 	state.UpdatedAt = types.StringValue(api.UpdatedAt)
 
-	scopeVal, d := types.MapValueFrom(ctx, types.StringType, api.Scope)
-	diags.Append(d...)
-	if scopeVal.IsNull() || scopeVal.IsUnknown() {
-		// fall back to plan/state scope map if API omitted
-		scopeVal, d = types.MapValueFrom(ctx, types.StringType, scope)
-		diags.Append(d...)
+	apiScope := api.Scope
+	if len(apiScope) == 0 {
+		// fall back to plan/state scope map if API omitted it or returned {},
+		// so an unset scope stays null rather than becoming an empty map
+		apiScope = scope
 	}
+	scopeVal, d := types.MapValueFrom(ctx, types.StringType, apiScope)
+	diags.Append(d...)
 	state.Scope = scopeVal
 
 	state.ScopeKind = types.StringValue(api.ScopeKind)
 	state.ScopeVersion = types.Int64Value(int64(api.ScopeVersion))
 
-	// Secret is never returned by API; keep the value we sent / already had.
-	secretVal, d := types.MapValueFrom(ctx, types.StringType, secret)
-	diags.Append(d...)
-	state.Secret = secretVal
+	// Secret is write-only and never returned by the API.
+	state.Secret = types.MapNull(types.StringType)
 }
 
 func optString(v types.String) *string {
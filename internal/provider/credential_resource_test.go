@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCredentialResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cred := h.resource("autoglue_credential")
+	config := map[string]any{
+		"name":                "route53",
+		"credential_provider": "aws",
+		"kind":                "aws_access_key",
+		"scope_kind":          "service",
+		"scope":               map[string]string{"service": "route53"},
+		"secret": map[string]string{
+			"access_key_id":     "AKIA...",
+			"secret_access_key": "s3cr3t",
+		},
+		"region": "us-east-1",
+	}
+	cred.Apply(config)
+
+	id := cred.Attr("id")
+	if got := api.credentials[id].secret["secret_access_key"]; got != "s3cr3t" {
+		t.Errorf("secret not sent to API, got %q", got)
+	}
+	if !cred.Attrs()["secret"].IsNull() {
+		t.Errorf("secret is write-only but was stored in state: %s", cred.Attrs()["secret"])
+	}
+
+	// Without a secret_version change, a new secret isn't sent.
+	config["name"] = "route53-prod"
+	config["secret"] = map[string]string{
+		"access_key_id":     "AKIA...",
+		"secret_access_key": "rotated",
+	}
+	cred.Apply(config)
+	if got := api.credentials[id].secret["secret_access_key"]; got != "s3cr3t" {
+		t.Errorf("secret sent without a secret_version change, got %q", got)
+	}
+	if got := api.credentials[id].Name; got != "route53-prod" {
+		t.Errorf("name not updated, got %q", got)
+	}
+
+	config["secret_version"] = 2
+	cred.Apply(config)
+
+	if cred.Attr("id") != id {
+		t.Errorf("update replaced the credential")
+	}
+	want := map[string]string{"access_key_id": "AKIA...", "secret_access_key": "rotated"}
+	if got := api.credentials[id].secret; !reflect.DeepEqual(got, want) {
+		t.Errorf("secret not rotated, got %v", got)
+	}
+
+	// secret_version only exists in config, so it cannot be verified on import.
+	cred.ImportVerify(id, "secret_version")
+
+	ds := h.ReadDataSource("autoglue_credential", map[string]any{"id": id})
+	if got := valueString(t, ds["name"]); got != "route53-prod" {
+		t.Errorf("data source name = %q, want route53-prod", got)
+	}
+
+	// Provider and kind are immutable server-side.
+	cred.ExpectReplace(map[string]any{
+		"name":                "route53-prod",
+		"credential_provider": "gcp",
+		"kind":                "aws_access_key",
+		"secret":              map[string]string{"k": "v"},
+	})
+
+	cred.Destroy()
+	if len(api.credentials) != 0 {
+		t.Errorf("expected credential to be deleted")
+	}
+}
+
+func TestCredentialResource_UpgradeStateV0(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cred := h.resource("autoglue_credential")
+	cred.Apply(map[string]any{
+		"name":                "route53",
+		"credential_provider": "aws",
+		"kind":                "aws_access_key",
+		"secret":              map[string]string{"secret_access_key": "s3cr3t"},
+	})
+	id := cred.Attr("id")
+
+	// Version 0 of the schema stored the secret in state.
+	upgraded := h.UpgradeState("autoglue_credential", 0, map[string]any{
+		"id":                  id,
+		"name":                "route53",
+		"credential_provider": "aws",
+		"kind":                "aws_access_key",
+		"schema_version":      1,
+		"scope":               nil,
+		"scope_kind":          cred.Attr("scope_kind"),
+		"scope_version":       1,
+		"secret":              map[string]string{"secret_access_key": "s3cr3t"},
+		"account_id":          nil,
+		"region":              nil,
+		"created_at":          cred.Attr("created_at"),
+		"updated_at":          cred.Attr("updated_at"),
+	})
+	if !upgraded.Attrs()["secret"].IsNull() {
+		t.Errorf("secret not removed from upgraded state: %s", upgraded.Attrs()["secret"])
+	}
+	upgraded.ExpectNoChanges(map[string]any{
+		"name":                "route53",
+		"credential_provider": "aws",
+		"kind":                "aws_access_key",
+		"secret":              map[string]string{"secret_access_key": "s3cr3t"},
+	})
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &domainDataSource{}
+	_ datasource.DataSourceWithConfigure        = &domainDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &domainDataSource{}
+)
+
+type domainDataSource struct {
+	client *autoglueClient
+}
+
+type domainDataSourceModel struct {
+	domainListItemModel
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func NewDomainDataSource() datasource.DataSource {
+	return &domainDataSource{}
+}
+
+func (d *domainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (d *domainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Reads a single Autoglue DNS domain by ID or domain name.",
+		Attributes: lookupAttributes(domainDataSourceAttributes(), map[string]string{
+			"id":          "Domain ID to look up. Exactly one of `id` or `domain_name` must be set.",
+			"domain_name": "DNS domain name to look up (no trailing dot).",
+		}),
+	}
+}
+
+func (d *domainDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("domain_name")),
+	}
+}
+
+func (d *domainDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config domainDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found domain
+	if id := filterValue(config.ID); id != "" {
+		tflog.Info(ctx, "Reading Autoglue domain data source", map[string]any{"id": id})
+
+		apiPath := fmt.Sprintf("/dns/domains/%s", id)
+		if err := d.client.doJSON(ctx, http.MethodGet, apiPath, "", nil, &found); err != nil {
+			if isNotFound(err) {
+				err = fmt.Errorf("no domain with id %q found", id)
+			}
+			resp.Diagnostics.AddError("Error reading domain", err.Error())
+			return
+		}
+	} else {
+		domainName := strings.TrimSuffix(strings.ToLower(filterValue(config.DomainName)), ".")
+		tflog.Info(ctx, "Looking up Autoglue domain by domain name", map[string]any{"domain_name": domainName})
+
+		items, err := listAll[domain](ctx, d.client, "/dns/domains", url.Values{"domain_name": {domainName}})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing domains", err.Error())
+			return
+		}
+		found, err = findOne(items,
+			func(v domain) bool { return strings.EqualFold(v.DomainName, domainName) },
+			func(v domain) string { return v.ID },
+			"domain", fmt.Sprintf("domain_name %q", domainName))
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up domain", err.Error())
+			return
+		}
+	}
+
+	state := domainDataSourceModel{domainDataSourceItem(found), config.OrgID}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
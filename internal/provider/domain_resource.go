@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,8 +35,12 @@ type domainResourceModel struct {
 	Status         types.String `tfsdk:"status"`
 	LastError      types.String `tfsdk:"last_error"`
 	OrganizationID types.String `tfsdk:"organization_id"`
+	OrgID          types.String `tfsdk:"org_id"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
+
+	WaitForReady types.Bool                 `tfsdk:"wait_for_ready"`
+	Timeouts     *createUpdateTimeoutsModel `tfsdk:"timeouts"`
 }
 
 func NewDomainResource() resource.Resource {
@@ -68,7 +75,7 @@ func (r *domainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"credential_id": resourceschema.StringAttribute{
 				Required: true,
 				Description: "Credential ID (UUID) bound to this domain. " +
-					"Must be an AWS Route 53 service-scoped credential.",
+					"Must be an AWS Route 53 service-scoped credential. Refresh warns if the credential no longer exists.",
 			},
 
 			"zone_id": resourceschema.StringAttribute{
@@ -86,11 +93,19 @@ func (r *domainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Provisioning status (pending, provisioning, ready, failed).",
 			},
 
+			"wait_for_ready": resourceschema.BoolAttribute{
+				Optional: true,
+				Description: "If true, create and update wait until the domain is verified and active (`ready`), " +
+					"polling with backoff up to the matching `timeouts` value. The apply fails with `last_error` " +
+					"if the domain lands on `failed` first. If omitted, the provider does not wait.",
+			},
+
 			"last_error": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Last provisioning error message, if any.",
 			},
 
+			"org_id": orgIDResourceAttribute(),
 			"organization_id": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -106,6 +121,9 @@ func (r *domainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Last update timestamp (RFC3339).",
 			},
 		},
+		Blocks: map[string]resourceschema.Block{
+			"timeouts": createUpdateTimeoutsBlock(),
+		},
 	}
 }
 
@@ -137,6 +155,17 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.CreateTimeout(defaultDomainCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := createDomainPayload{
 		DomainName:   plan.DomainName.ValueString(),
 		CredentialID: plan.CredentialID.ValueString(),
@@ -158,6 +187,15 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 	syncDomainFromAPI(&plan, &apiResp)
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State is saved before waiting so a failed or timed-out wait leaves the
+	// domain tracked (and tainted) instead of orphaned.
+	r.waitForReady(ctx, &plan, timeout, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -173,6 +211,11 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.State.RemoveResource(ctx)
@@ -193,10 +236,39 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	syncDomainFromAPI(&state, &apiResp)
+	r.checkCredential(ctx, &state, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
+// checkCredential warns if the domain's credential has been deleted. Other
+// errors are only logged so a flaky lookup doesn't block refresh. Whether the
+// credential can still access the hosted zone isn't checked: the API has no
+// endpoint that reports it.
+func (r *domainResource) checkCredential(ctx context.Context, model *domainResourceModel, diags *diag.Diagnostics) {
+	credentialID := model.CredentialID.ValueString()
+	if credentialID == "" {
+		return
+	}
+
+	err := r.client.doJSON(ctx, http.MethodGet, fmt.Sprintf("/credentials/%s", credentialID), "", nil, nil)
+	switch {
+	case isNotFound(err):
+		diags.AddAttributeWarning(
+			path.Root("credential_id"),
+			"Domain credential not found",
+			fmt.Sprintf("Credential %s used by domain %s no longer exists. Record sets in this domain will fail to "+
+				"publish until credential_id is changed.", credentialID, model.DomainName.ValueString()),
+		)
+	case err != nil:
+		tflog.Warn(ctx, "Could not check the credential of Autoglue domain", map[string]any{
+			"id":            model.ID.ValueString(),
+			"credential_id": credentialID,
+			"error":         err.Error(),
+		})
+	}
+}
+
 func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
@@ -217,12 +289,23 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Domain ID is required in state to update domain.")
 		return
 	}
 
+	timeout, diags := plan.Timeouts.UpdateTimeout(defaultDomainUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var payload updateDomainPayload
 
 	// We only support updating credential_id and zone_id via Terraform.
@@ -236,7 +319,11 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if payload.CredentialID == nil && payload.ZoneID == nil {
-		// Nothing to update
+		// Only wait_for_ready or timeouts changed; nothing to send to the API.
+		syncDomainModelComputed(&plan, &state)
+		r.waitForReady(ctx, &plan, timeout, &resp.Diagnostics)
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
@@ -255,6 +342,7 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	syncDomainFromAPI(&plan, &apiResp)
+	r.waitForReady(ctx, &plan, timeout, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -272,6 +360,11 @@ func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		return
@@ -289,8 +382,48 @@ func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_domain.example <domain_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_domain.example <domain_id> or <org_id>/<domain_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
+}
+
+// waitForReady blocks until the domain is verified, if model.WaitForReady is
+// set, refreshing model from the last poll and reporting failures to diags.
+func (r *domainResource) waitForReady(ctx context.Context, model *domainResourceModel, timeout time.Duration, diags *diag.Diagnostics) {
+	if !model.WaitForReady.ValueBool() || model.Status.ValueString() == domainStatusReady {
+		return
+	}
+
+	id := model.ID.ValueString()
+	tflog.Info(ctx, "Waiting for Autoglue domain to be ready", map[string]any{
+		"id":      id,
+		"timeout": timeout.String(),
+	})
+
+	last, err := r.client.waitForDomainReady(ctx, id, timeout)
+	if last != nil {
+		syncDomainFromAPI(model, last)
+	}
+	switch {
+	case errors.Is(err, errDomainFailed):
+		detail := fmt.Sprintf("Domain %s entered status %q while waiting for %q.", id, domainStatusFailed, domainStatusReady)
+		if last != nil && last.LastError != "" {
+			detail += "\n\nlast_error: " + last.LastError
+		}
+		diags.AddAttributeError(path.Root("status"), "Domain verification failed", detail)
+	case err != nil:
+		diags.AddError("Error waiting for domain", err.Error())
+	}
+}
+
+// syncDomainModelComputed copies server-populated attributes from src to dst.
+func syncDomainModelComputed(dst, src *domainResourceModel) {
+	dst.ID = src.ID
+	dst.ZoneID = src.ZoneID
+	dst.Status = src.Status
+	dst.LastError = src.LastError
+	dst.OrganizationID = src.OrganizationID
+	dst.CreatedAt = src.CreatedAt
+	dst.UpdatedAt = src.UpdatedAt
 }
 
 func syncDomainFromAPI(state *domainResourceModel, api *domain) {
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func applyTestCredential(t *testing.T, h *testHarness) *testResource {
+	t.Helper()
+	cred := h.resource("autoglue_credential")
+	cred.Apply(map[string]any{
+		"name":                "route53",
+		"credential_provider": "aws",
+		"kind":                "aws_access_key",
+		"secret":              map[string]string{"access_key_id": "a", "secret_access_key": "b"},
+	})
+	return cred
+}
+
+func TestDomainResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	cred := applyTestCredential(t, h)
+
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{
+		"domain_name":   "example.com",
+		"credential_id": cred.Attr("id"),
+	})
+	id := domain.Attr("id")
+
+	domain.Apply(map[string]any{
+		"domain_name":   "example.com",
+		"credential_id": cred.Attr("id"),
+		"zone_id":       "Z123",
+	})
+	if domain.Attr("id") != id || api.domains[id].ZoneID != "Z123" {
+		t.Errorf("zone_id not updated in place: %+v", api.domains[id])
+	}
+
+	domain.ImportVerify(id)
+
+	list := h.ReadDataSource("autoglue_domains", map[string]any{"domain_name": "example.com"})
+	if got := len(objectElems(t, list["domains"])); got != 1 {
+		t.Errorf("domains data source returned %d domains, want 1", got)
+	}
+
+	byName := h.ReadDataSource("autoglue_domain", map[string]any{"domain_name": "Example.com."})
+	if got := valueString(t, byName["id"]); got != id {
+		t.Errorf("domain data source by domain_name returned id %q, want %q", got, id)
+	}
+
+	domain.ExpectReplace(map[string]any{
+		"domain_name":   "example.org",
+		"credential_id": cred.Attr("id"),
+	})
+
+	domain.Destroy()
+	cred.Destroy()
+}
+
+func TestDomainResource_CredentialDeleted(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	cred := applyTestCredential(t, h)
+
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{
+		"domain_name":   "example.com",
+		"credential_id": cred.Attr("id"),
+	})
+
+	delete(api.credentials, cred.Attr("id"))
+	domain.RefreshExpectWarning("no longer exists")
+}
+
+// fastDomainPolling shortens domain wait intervals for the duration of a test.
+func fastDomainPolling(t *testing.T) {
+	t.Helper()
+	initial, max := domainPollInitialInterval, domainPollMaxInterval
+	domainPollInitialInterval, domainPollMaxInterval = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() {
+		domainPollInitialInterval, domainPollMaxInterval = initial, max
+	})
+}
+
+func TestDomainResource_WaitForReady(t *testing.T) {
+	fastDomainPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	cred := applyTestCredential(t, h)
+
+	api.domainStatuses = []string{"pending", "provisioning", "ready"}
+
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{
+		"domain_name":    "example.com",
+		"credential_id":  cred.Attr("id"),
+		"wait_for_ready": true,
+		"timeouts":       map[string]any{"create": "1m"},
+	})
+	if got := domain.Attr("status"); got != "ready" {
+		t.Fatalf("status = %q, want ready", got)
+	}
+}
+
+func TestDomainResource_WaitForReadyFailed(t *testing.T) {
+	fastDomainPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	cred := applyTestCredential(t, h)
+
+	api.domainStatuses = []string{"pending", "failed"}
+	api.domainFailedError = "NS records do not point at the hosted zone"
+
+	domain := h.resource("autoglue_domain")
+	domain.ApplyExpectError(map[string]any{
+		"domain_name":    "example.com",
+		"credential_id":  cred.Attr("id"),
+		"wait_for_ready": true,
+	}, "last_error: NS records do not point at the hosted zone")
+	if domain.state.IsNull() {
+		t.Fatalf("expected the failed domain to be kept in state")
+	}
+}
+
+func TestRecordSetResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	cred := applyTestCredential(t, h)
+
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{
+		"domain_name":   "example.com",
+		"credential_id": cred.Attr("id"),
+	})
+
+	rs := h.resource("autoglue_record_set")
+	config := map[string]any{
+		"domain_id": domain.Attr("id"),
+		"name":      "api",
+		"type":      "A",
+		"ttl":       300,
+		"values":    []string{"203.0.113.10"},
+	}
+	rs.Apply(config)
+	id := rs.Attr("id")
+
+	config["values"] = []string{"203.0.113.10", "203.0.113.11"}
+	config["ttl"] = 60
+	rs.Apply(config)
+
+	got := api.recordSets[id]
+	if rs.Attr("id") != id || !reflect.DeepEqual(got.Values, []string{"203.0.113.10", "203.0.113.11"}) || *got.TTL != 60 {
+		t.Errorf("record set not updated in place: %+v", got)
+	}
+
+	rs.ImportVerify(id)
+
+	list := h.ReadDataSource("autoglue_record_sets", map[string]any{
+		"domain_id": domain.Attr("id"),
+		"type":      "a",
+	})
+	if got := len(objectElems(t, list["records"])); got != 1 {
+		t.Errorf("record_sets data source returned %d records, want 1", got)
+	}
+
+	for _, name := range []string{"api", "api.example.com", "API.example.com."} {
+		found := h.ReadDataSource("autoglue_record_set", map[string]any{
+			"domain_id": domain.Attr("id"),
+			"name":      name,
+		})
+		if got := valueString(t, found["id"]); got != id {
+			t.Errorf("record_set data source by name %q returned id %q, want %q", name, got, id)
+		}
+	}
+	h.ReadDataSourceExpectError("autoglue_record_set", map[string]any{
+		"domain_id": domain.Attr("id"),
+		"name":      "api",
+		"type":      "TXT",
+	}, `no record set with name "api" and type TXT found`)
+	h.ReadDataSourceExpectError("autoglue_record_set", map[string]any{"name": "api"}, "Invalid Attribute Combination")
+
+	rs.Destroy()
+	domain.Destroy()
+	cred.Destroy()
+	if len(api.recordSets) != 0 || len(api.domains) != 0 {
+		t.Errorf("expected DNS objects to be deleted")
+	}
+}
+
+// fastRecordSetPolling shortens record set wait intervals for the duration of a test.
+func fastRecordSetPolling(t *testing.T) {
+	t.Helper()
+	initial, max := recordSetPollInitialInterval, recordSetPollMaxInterval
+	recordSetPollInitialInterval, recordSetPollMaxInterval = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() {
+		recordSetPollInitialInterval, recordSetPollMaxInterval = initial, max
+	})
+}
+
+func applyTestDomain(t *testing.T, h *testHarness) *testResource {
+	t.Helper()
+	cred := applyTestCredential(t, h)
+	domain := h.resource("autoglue_domain")
+	domain.Apply(map[string]any{
+		"domain_name":   "example.com",
+		"credential_id": cred.Attr("id"),
+	})
+	return domain
+}
+
+func TestRecordSetResource_WaitForReady(t *testing.T) {
+	fastRecordSetPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	domain := applyTestDomain(t, h)
+
+	api.recordSetStatuses = []string{"pending", "provisioning", "ready"}
+
+	rs := h.resource("autoglue_record_set")
+	config := map[string]any{
+		"domain_id":      domain.Attr("id"),
+		"name":           "api",
+		"type":           "A",
+		"ttl":            300,
+		"values":         []string{"203.0.113.10"},
+		"wait_for_ready": true,
+		"timeouts":       map[string]any{"create": "1m"},
+	}
+	rs.Apply(config)
+
+	if got := rs.Attr("status"); got != "ready" {
+		t.Fatalf("status = %q, want ready", got)
+	}
+
+	// An update republishes the record set, so the wait starts over.
+	api.recordSetStatuses = []string{"provisioning", "ready"}
+	config["values"] = []string{"203.0.113.11"}
+	rs.Apply(config)
+	if got := rs.Attr("status"); got != "ready" {
+		t.Errorf("status after update = %q, want ready", got)
+	}
+	if got, want := rs.Attr("fingerprint"), api.recordSets[rs.Attr("id")].Fingerprint; got != want {
+		t.Errorf("fingerprint after update = %q, want %q", got, want)
+	}
+	if len(api.recordSetStatuses) != 1 {
+		t.Errorf("update did not wait for the record set, statuses left: %q", api.recordSetStatuses)
+	}
+	rs.ExpectNoChanges(config)
+}
+
+func TestRecordSetResource_WaitForReadyFailed(t *testing.T) {
+	fastRecordSetPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	domain := applyTestDomain(t, h)
+
+	api.recordSetStatuses = []string{"pending", "failed"}
+	api.recordSetFailedError = "zone not found"
+
+	rs := h.resource("autoglue_record_set")
+	rs.ApplyExpectError(map[string]any{
+		"domain_id":      domain.Attr("id"),
+		"name":           "api",
+		"type":           "A",
+		"values":         []string{"203.0.113.10"},
+		"wait_for_ready": true,
+	}, "last_error: zone not found")
+	if rs.state.IsNull() {
+		t.Fatalf("expected the failed record set to be kept in state")
+	}
+	if got := rs.Attr("status"); got != "failed" {
+		t.Errorf("status = %q, want failed", got)
+	}
+}
+
+func TestRecordSetResource_WaitForReadyTimeout(t *testing.T) {
+	fastRecordSetPolling(t)
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+	domain := applyTestDomain(t, h)
+
+	api.recordSetStatuses = []string{"provisioning"}
+
+	rs := h.resource("autoglue_record_set")
+	rs.ApplyExpectError(map[string]any{
+		"domain_id":      domain.Attr("id"),
+		"name":           "api",
+		"type":           "A",
+		"values":         []string{"203.0.113.10"},
+		"wait_for_ready": true,
+		"timeouts":       map[string]any{"create": "50ms"},
+	}, `(last status "provisioning")`)
+}
+
+func TestRecordSetResource_Validation(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	rs := h.resource("autoglue_record_set")
+	config := func(typ string, values ...string) map[string]any {
+		return map[string]any{"domain_id": "d", "name": "api", "type": typ, "values": values}
+	}
+	rs.ApplyExpectError(config("PTR", "host.example.com"), "value must be one of")
+	rs.ApplyExpectError(config("A", "2001:db8::1"), "not an IPv4 address")
+	rs.ApplyExpectError(config("CNAME", "a.example.com", "b.example.com"), "exactly one value")
+	rs.ApplyExpectError(config("TXT", "v=spf1 -all"), "must be quoted")
+	if len(api.recordSets) != 0 {
+		t.Errorf("invalid record sets reached the API")
+	}
+}
+
+func TestValidateRecordValue(t *testing.T) {
+	tests := []struct {
+		typ, value string
+		valid      bool
+	}{
+		{"A", "203.0.113.10", true},
+		{"A", "2001:db8::1", false},
+		{"A", "::ffff:203.0.113.10", false},
+		{"A", "example.com", false},
+		{"AAAA", "2001:db8::10", true},
+		{"AAAA", "203.0.113.10", false},
+		{"AAAA", "::ffff:203.0.113.10", false},
+		{"CNAME", "lb.example.net.", true},
+		{"CNAME", "lb example.net", false},
+		{"NS", "ns1.example.net", true},
+		{"NS", "-ns.example.net", false},
+		{"MX", "10 mail.example.com", true},
+		{"MX", "mail.example.com", false},
+		{"MX", "70000 mail.example.com", false},
+		{"SRV", "10 5 443 api.example.com", true},
+		{"SRV", "0 0 0 .", true},
+		{"SRV", "10 5 api.example.com", false},
+		{"SRV", "10 5 99999 api.example.com", false},
+		{"TXT", `"v=spf1 -all"`, true},
+		{"TXT", `"part one" "part \"two\""`, true},
+		{"TXT", `v=spf1 -all`, false},
+		{"TXT", `"unterminated`, false},
+		{"TXT", `"a""b"`, false},
+		{"TXT", `"` + strings.Repeat("x", 256) + `"`, false},
+		{"CAA", `0 issue "letsencrypt.org"`, true},
+		{"CAA", `0 issue letsencrypt.org`, false},
+		{"CAA", `256 issue "letsencrypt.org"`, false},
+	}
+	for _, tt := range tests {
+		err := validateRecordValue(tt.typ, tt.value)
+		if (err == nil) != tt.valid {
+			t.Errorf("validateRecordValue(%s, %q) = %v, want valid=%v", tt.typ, tt.value, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	domainStatusReady  = "ready"
+	domainStatusFailed = "failed"
+
+	defaultDomainCreateTimeout = 15 * time.Minute
+	defaultDomainUpdateTimeout = 15 * time.Minute
+)
+
+// Poll intervals for domain waits. Variables so tests can shorten them.
+var (
+	domainPollInitialInterval = 2 * time.Second
+	domainPollMaxInterval     = 30 * time.Second
+)
+
+// errDomainFailed is returned by waitForDomainReady when verification fails.
+var errDomainFailed = errors.New("domain verification failed")
+
+// waitForDomainReady polls GET /dns/domains/{id} until the domain is verified
+// ("ready"), fails, or timeout elapses. The last observed domain is returned
+// in every case so callers can persist it.
+func (c *autoglueClient) waitForDomainReady(ctx context.Context, id string, timeout time.Duration) (*domain, error) {
+	ctx, cancel := context.WithTimeout(withFreshReads(ctx), timeout)
+	defer cancel()
+
+	path := fmt.Sprintf("/dns/domains/%s", id)
+	interval := domainPollInitialInterval
+
+	var last *domain
+	for {
+		var apiResp domain
+		if err := c.doJSON(ctx, http.MethodGet, path, "", nil, &apiResp); err != nil {
+			if ctx.Err() != nil {
+				return last, domainWaitTimeoutError(id, timeout, last)
+			}
+			return last, err
+		}
+		last = &apiResp
+
+		tflog.Debug(ctx, "Polled Autoglue domain status", map[string]any{
+			"id":     id,
+			"status": apiResp.Status,
+		})
+
+		switch apiResp.Status {
+		case domainStatusReady:
+			return last, nil
+		case domainStatusFailed:
+			return last, errDomainFailed
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return last, domainWaitTimeoutError(id, timeout, last)
+		}
+		interval = min(interval*2, domainPollMaxInterval)
+	}
+}
+
+func domainWaitTimeoutError(id string, timeout time.Duration, last *domain) error {
+	status := "unknown"
+	if last != nil {
+		status = last.Status
+	}
+	return fmt.Errorf("timed out after %s waiting for domain %s to be ready (last status %q)", timeout, id, status)
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strings"
 
@@ -28,6 +27,8 @@ type domainsDataSourceModel struct {
 	Search     types.String `tfsdk:"search"`
 
 	Domains []domainListItemModel `tfsdk:"domains"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type domainListItemModel struct {
@@ -72,46 +73,52 @@ func (d *domainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:    true,
 				Description: "Matching domains.",
 				NestedObject: dsschema.NestedAttributeObject{
-					Attributes: map[string]dsschema.Attribute{
-						"id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Domain ID.",
-						},
-						"organization_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Owning organization UUID.",
-						},
-						"domain_name": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "DNS domain name.",
-						},
-						"zone_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Route 53 zone ID backing this domain.",
-						},
-						"status": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Provisioning status.",
-						},
-						"last_error": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last provisioning error, if any.",
-						},
-						"credential_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Credential ID bound to this domain.",
-						},
-						"created_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Creation timestamp.",
-						},
-						"updated_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last update timestamp.",
-						},
-					},
+					Attributes: domainDataSourceAttributes(),
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// domainDataSourceAttributes describes a domain as exposed by the domain and domains data sources.
+func domainDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Domain ID.",
+		},
+		"organization_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Owning organization UUID.",
+		},
+		"domain_name": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "DNS domain name.",
+		},
+		"zone_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Route 53 zone ID backing this domain.",
+		},
+		"status": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Provisioning status.",
+		},
+		"last_error": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last provisioning error, if any.",
+		},
+		"credential_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Credential ID bound to this domain.",
+		},
+		"created_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Creation timestamp.",
+		},
+		"updated_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last update timestamp.",
 		},
 	}
 }
@@ -144,6 +151,11 @@ func (d *domainsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	q := url.Values{}
 	if !config.DomainName.IsNull() && !config.DomainName.IsUnknown() {
 		q.Set("domain_name", strings.TrimSpace(strings.ToLower(config.DomainName.ValueString())))
@@ -162,28 +174,39 @@ func (d *domainsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		"query": query,
 	})
 
-	var apiResp []domain
-	if err := d.client.doJSON(ctx, http.MethodGet, path, query, nil, &apiResp); err != nil {
+	apiResp, err := listAll[domain](ctx, d.client, path, q)
+	if err != nil {
 		resp.Diagnostics.AddError("Error listing domains", err.Error())
 		return
 	}
 
 	config.Domains = make([]domainListItemModel, 0, len(apiResp))
 	for _, dom := range apiResp {
-		item := domainListItemModel{
-			ID:             types.StringValue(dom.ID),
-			OrganizationID: types.StringValue(dom.OrganizationID),
-			DomainName:     types.StringValue(dom.DomainName),
-			ZoneID:         types.StringValue(dom.ZoneID),
-			Status:         types.StringValue(dom.Status),
-			LastError:      types.StringValue(dom.LastError),
-			CredentialID:   types.StringValue(dom.CredentialID),
-			CreatedAt:      types.StringValue(dom.CreatedAt),
-			UpdatedAt:      types.StringValue(dom.UpdatedAt),
+		// The filters are re-checked normalized as sent.
+		if !matchesExact(types.StringValue(q.Get("domain_name")), dom.DomainName) ||
+			!matchesExact(types.StringValue(q.Get("status")), dom.Status) {
+			continue
 		}
-		config.Domains = append(config.Domains, item)
+
+		config.Domains = append(config.Domains, domainDataSourceItem(dom))
 	}
 
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 }
+
+// domainDataSourceItem converts an API domain into its data source representation.
+func domainDataSourceItem(dom domain) domainListItemModel {
+	item := domainListItemModel{
+		ID:             types.StringValue(dom.ID),
+		OrganizationID: types.StringValue(dom.OrganizationID),
+		DomainName:     types.StringValue(dom.DomainName),
+		ZoneID:         types.StringValue(dom.ZoneID),
+		Status:         types.StringValue(dom.Status),
+		LastError:      types.StringValue(dom.LastError),
+		CredentialID:   types.StringValue(dom.CredentialID),
+		CreatedAt:      types.StringValue(dom.CreatedAt),
+		UpdatedAt:      types.StringValue(dom.UpdatedAt),
+	}
+	return item
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &fingerprintFunction{}
+
+type fingerprintFunction struct{}
+
+func NewFingerprintFunction() function.Function {
+	return &fingerprintFunction{}
+}
+
+func (f *fingerprintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fingerprint"
+}
+
+func (f *fingerprintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the fingerprint of an SSH public key.",
+		Description: "Returns the SHA256 fingerprint of an SSH public key in the format the API reports " +
+			"for `autoglue_ssh_key` (and `ssh-keygen -l` prints), e.g. `SHA256:MLTgHCn8GrGmWwlht1jVr7EkaeC9+feKQwfS2CE4qUs`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "public_key",
+				Description: "Public key in OpenSSH authorized_keys format, e.g. `ssh-ed25519 AAAA... comment`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *fingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKey string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	fp, err := sshFingerprint(publicKey)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, fp))
+}
+
+// sshFingerprint returns the unpadded base64 SHA256 fingerprint of an
+// authorized_keys style public key, prefixed with "SHA256:".
+func sshFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("expected a public key of the form \"<type> <base64 key> [comment]\"")
+	}
+	keyType := fields[0]
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("public key data is not valid base64: %w", err)
+	}
+
+	// The key blob starts with its own length-prefixed type name.
+	if len(blob) < 4 {
+		return "", fmt.Errorf("public key data is too short")
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(len(blob)-4) < uint64(n) {
+		return "", fmt.Errorf("public key data is truncated")
+	}
+	if embedded := string(blob[4 : 4+n]); embedded != keyType {
+		return "", fmt.Errorf("public key type %q does not match its data (%q)", keyType, embedded)
+	}
+
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFunctions(t *testing.T) {
+	h := newUnconfiguredHarness(t)
+
+	const edKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINBHWW83Mds4vKt59d8+gCvRzgLcmxtU2BxkADQNi7f3 deploy@autoglue"
+
+	cases := []struct {
+		fn      string
+		args    []any
+		want    string
+		wantErr string
+	}{
+		{fn: "control_plane_fqdn", args: []any{"api", "example.com"}, want: "api.example.com"},
+		{fn: "control_plane_fqdn", args: []any{"API", "Example.com."}, want: "api.example.com"},
+		{fn: "control_plane_fqdn", args: []any{"api.example.com.", "example.com"}, want: "api.example.com"},
+		{fn: "control_plane_fqdn", args: []any{"@", "example.com"}, want: "example.com"},
+		{fn: "control_plane_fqdn", args: []any{"api", " . "}, wantErr: "domain_name must not be empty"},
+
+		{fn: "taint_string", args: []any{"dedicated", "gpu", "NoSchedule"}, want: "dedicated=gpu:NoSchedule"},
+		{fn: "taint_string", args: []any{"dedicated", nil, "NoExecute"}, want: "dedicated:NoExecute"},
+		{fn: "taint_string", args: []any{"dedicated", "", "PreferNoSchedule"}, want: "dedicated:PreferNoSchedule"},
+		{fn: "taint_string", args: []any{"dedicated", "gpu", "noschedule"}, wantErr: "value must be one of"},
+		{fn: "taint_string", args: []any{"", "gpu", "NoSchedule"}, wantErr: "length must be at least 1"},
+
+		{fn: "fingerprint", args: []any{edKey}, want: "SHA256:MLTgHCn8GrGmWwlht1jVr7EkaeC9+feKQwfS2CE4qUs"},
+		{fn: "fingerprint", args: []any{"ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQCpjSH5sMXAq3SK6HfBW8ImEptrfWWm4Pdr2+IfsyF1rAy2qrsTkOiNatBIvr+BmgJ4V1pMG6wkdLOLoXzTilUEzn0blEUeKVex9r1gg744ofjH8g+CCKn+NYdX4U+WfsnWW8tel3Qzr5vP1Js0H8o8SnojRw3Itr8CDk7Cum4ctw=="},
+			want: "SHA256:m7oOqsGJTZPkdMTE8E4SnrMUIpiPgLvbOYQCWfsbAVM"},
+		{fn: "fingerprint", args: []any{strings.Replace(edKey, "ssh-ed25519", "ssh-rsa", 1)}, wantErr: "does not match its data"},
+		{fn: "fingerprint", args: []any{"ssh-ed25519"}, wantErr: "expected a public key"},
+		{fn: "fingerprint", args: []any{"ssh-ed25519 not-base64!"}, wantErr: "not valid base64"},
+	}
+
+	for _, tc := range cases {
+		got, errText := h.CallFunction(tc.fn, tc.args...)
+		if tc.wantErr != "" {
+			if !strings.Contains(errText, tc.wantErr) {
+				t.Errorf("%s%q: expected error containing %q, got %q", tc.fn, tc.args, tc.wantErr, errText)
+			}
+			continue
+		}
+		if errText != "" {
+			t.Errorf("%s%q: unexpected error: %s", tc.fn, tc.args, errText)
+			continue
+		}
+		if s := valueString(t, got); s != tc.want {
+			t.Errorf("%s%q = %q, want %q", tc.fn, tc.args, s, tc.want)
+		}
+	}
+}
+
+func TestParseImportIDFunction(t *testing.T) {
+	h := newUnconfiguredHarness(t)
+
+	got, errText := h.CallFunction("parse_import_id", "autoglue_org_member", "org-1/user-2")
+	if errText != "" {
+		t.Fatalf("unexpected error: %s", errText)
+	}
+	attrs := map[string]string{}
+	for k, v := range objectAttrs(t, got) {
+		attrs[k] = valueString(t, v)
+	}
+	if len(attrs) != 2 || attrs["org_id"] != "org-1" || attrs["user_id"] != "user-2" {
+		t.Errorf("parse_import_id = %v", attrs)
+	}
+
+	if _, errText := h.CallFunction("parse_import_id", "autoglue_org_member", "org-1"); !strings.Contains(errText, "expected format: <org_id>/<user_id>") {
+		t.Errorf("expected format error, got %q", errText)
+	}
+	// Org-scoped composite IDs accept the same "<org_id>/" prefix as import.
+	got, errText = h.CallFunction("parse_import_id", "autoglue_cluster_metadata", "org-1/cluster-2/meta-3")
+	if errText != "" {
+		t.Fatalf("unexpected error: %s", errText)
+	}
+	attrs = map[string]string{}
+	for k, v := range objectAttrs(t, got) {
+		attrs[k] = valueString(t, v)
+	}
+	if len(attrs) != 3 || attrs["org_id"] != "org-1" || attrs["cluster_id"] != "cluster-2" || attrs["id"] != "meta-3" {
+		t.Errorf("parse_import_id with org prefix = %v", attrs)
+	}
+	for _, id := range []string{"/cluster-2/meta-3", "org-1/cluster-2/meta-3/extra"} {
+		if _, errText := h.CallFunction("parse_import_id", "autoglue_cluster_metadata", id); !strings.Contains(errText, "<org_id>/<cluster_id>/<metadata_id>") {
+			t.Errorf("parse_import_id(%q): expected format error, got %q", id, errText)
+		}
+	}
+	if _, errText := h.CallFunction("parse_import_id", "autoglue_org_member", "org-1/user-2/extra"); !strings.Contains(errText, "expected format: <org_id>/<user_id>") {
+		t.Errorf("expected format error for an extra part, got %q", errText)
+	}
+
+	if _, errText := h.CallFunction("parse_import_id", "autoglue_server", "srv-1"); !strings.Contains(errText, "supported resource types: autoglue_cluster_metadata") {
+		t.Errorf("expected unsupported type error, got %q", errText)
+	}
+}
@@ -0,0 +1,888 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testHarness drives the provider over the plugin protocol the same way
+// Terraform core does: validate, plan, apply, refresh and re-plan, import and
+// destroy. Configuration is given as plain Go values keyed by attribute name
+// instead of HCL, so no terraform binary is needed.
+//
+// Value conventions for configs: string, bool, int/int64/float64 for
+// numbers, []string or []any for lists and sets, map[string]string or
+// map[string]any for maps and nested objects, and nil for null.
+//
+// The harness is not terraform-plugin-testing: that needs a terraform binary,
+// which these tests must run without. It only covers the provider's side of
+// the protocol, so Terraform core behavior such as HCL evaluation, version
+// constraints and state upgrades is not exercised here.
+type testHarness struct {
+	t       *testing.T
+	ctx     context.Context
+	server  tfprotov6.ProviderServer
+	schemas *tfprotov6.GetProviderSchemaResponse
+}
+
+// harnessTerraformVersion is the Terraform version the harness reports to the
+// provider. Write-only attributes need Terraform 1.11 or later, so the
+// harness claims the oldest version that supports the whole provider.
+const harnessTerraformVersion = "1.11.0"
+
+// newTestHarness returns a harness whose provider is configured against api.
+// Tests change the fake between steps, which stand for separate Terraform
+// runs, so the read cache is off.
+func newTestHarness(t *testing.T, api *fakeAPI) *testHarness {
+	t.Helper()
+	return newTestHarnessWithConfig(t, map[string]any{
+		"base_url":       api.URL(),
+		"api_key":        api.apiKey,
+		"org_id":         api.orgID,
+		"read_cache_ttl": "0s",
+	})
+}
+
+// newTestHarnessWithConfig returns a harness configured with providerConfig.
+func newTestHarnessWithConfig(t *testing.T, providerConfig map[string]any) *testHarness {
+	t.Helper()
+
+	h := newUnconfiguredHarness(t)
+	h.requireNoErrors("ConfigureProvider", h.configure(providerConfig))
+	return h
+}
+
+// ConfigureExpectError configures the provider and requires an error containing substr.
+func (h *testHarness) ConfigureExpectError(providerConfig map[string]any, substr string) {
+	h.t.Helper()
+	diags := h.configure(providerConfig)
+	if !hasErrors(diags) || !strings.Contains(formatDiags(diags), substr) {
+		h.t.Fatalf("ConfigureProvider: expected error containing %q, got:%s", substr, formatDiags(diags))
+	}
+}
+
+func (h *testHarness) configure(providerConfig map[string]any) []*tfprotov6.Diagnostic {
+	h.t.Helper()
+	cfg := h.configValue(h.schemas.Provider.Block, providerConfig)
+	resp, err := h.server.ConfigureProvider(h.ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: harnessTerraformVersion,
+		Config:           h.dynamic(cfg),
+	})
+	if err != nil {
+		h.t.Fatalf("ConfigureProvider: %s", err)
+	}
+	return resp.Diagnostics
+}
+
+// newUnconfiguredHarness returns a harness that has loaded schemas but not
+// called ConfigureProvider.
+func newUnconfiguredHarness(t *testing.T) *testHarness {
+	t.Helper()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	h := &testHarness{t: t, ctx: context.Background(), server: server}
+
+	schemas, err := server.GetProviderSchema(h.ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+	h.requireNoErrors("GetProviderSchema", schemas.Diagnostics)
+	h.schemas = schemas
+	return h
+}
+
+// testResource tracks the state of a single managed resource instance.
+type testResource struct {
+	h        *testHarness
+	typeName string
+	schema   *tfprotov6.Schema
+	state    tftypes.Value
+	private  []byte
+}
+
+// resource returns a handle for a new, not yet created, instance of typeName.
+func (h *testHarness) resource(typeName string) *testResource {
+	h.t.Helper()
+	s, ok := h.schemas.ResourceSchemas[typeName]
+	if !ok {
+		h.t.Fatalf("unknown resource type %s", typeName)
+	}
+	typ := s.ValueType()
+	return &testResource{h: h, typeName: typeName, schema: s, state: tftypes.NewValue(typ, nil)}
+}
+
+// Apply plans and applies config against the current state, replacing the
+// instance if the plan requires it. It then refreshes the instance and
+// checks that re-planning the same config produces no changes.
+func (r *testResource) Apply(config map[string]any) {
+	r.h.t.Helper()
+	if diags := r.apply(config); hasErrors(diags) {
+		r.h.t.Fatalf("%s apply: %s", r.typeName, formatDiags(diags))
+	}
+	r.Refresh()
+	r.ExpectNoChanges(config)
+}
+
+// ApplyExpectError applies config and requires it to fail with an error
+// diagnostic containing substr. State is whatever the provider returned.
+func (r *testResource) ApplyExpectError(config map[string]any, substr string) {
+	r.h.t.Helper()
+	diags := r.apply(config)
+	if !hasErrors(diags) {
+		r.h.t.Fatalf("%s apply: expected error containing %q, got none", r.typeName, substr)
+	}
+	if !strings.Contains(formatDiags(diags), substr) {
+		r.h.t.Fatalf("%s apply: expected error containing %q, got: %s", r.typeName, substr, formatDiags(diags))
+	}
+}
+
+func (r *testResource) apply(config map[string]any) []*tfprotov6.Diagnostic {
+	r.h.t.Helper()
+
+	cfg := r.h.configValue(r.schema.Block, config)
+	if diags := r.validate(cfg); hasErrors(diags) {
+		return diags
+	}
+
+	plan, diags := r.plan(r.state, cfg)
+	if hasErrors(diags) {
+		return diags
+	}
+
+	if !r.state.IsNull() && len(plan.RequiresReplace) > 0 {
+		r.Destroy()
+		plan, diags = r.plan(r.state, cfg)
+		if hasErrors(diags) {
+			return diags
+		}
+	}
+
+	planned, err := plan.PlannedState.Unmarshal(r.schema.ValueType())
+	if err != nil {
+		r.h.t.Fatalf("%s: decoding planned state: %s", r.typeName, err)
+	}
+	if planned.Equal(r.state) {
+		return diags
+	}
+
+	resp, err := r.h.server.ApplyResourceChange(r.h.ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       r.typeName,
+		PriorState:     r.h.dynamic(r.state),
+		PlannedState:   plan.PlannedState,
+		Config:         r.h.dynamic(cfg),
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		r.h.t.Fatalf("%s ApplyResourceChange: %s", r.typeName, err)
+	}
+	diags = append(diags, resp.Diagnostics...)
+
+	if resp.NewState != nil {
+		newState, err := resp.NewState.Unmarshal(r.schema.ValueType())
+		if err != nil {
+			r.h.t.Fatalf("%s: decoding new state: %s", r.typeName, err)
+		}
+		if !newState.IsFullyKnown() {
+			r.h.t.Fatalf("%s: apply left unknown values in state: %s", r.typeName, newState)
+		}
+		// Terraform rejects providers that return something other than a
+		// known planned value ("Provider produced inconsistent result").
+		if !newState.IsNull() && !hasErrors(diags) {
+			newAttrs := objectAttrs(r.h.t, newState)
+			for name, want := range objectAttrs(r.h.t, planned) {
+				if want.IsFullyKnown() && !want.Equal(newAttrs[name]) {
+					r.h.t.Fatalf("%s: inconsistent result after apply: %s planned as %s, got %s", r.typeName, name, want, newAttrs[name])
+				}
+			}
+		}
+		r.state = newState
+		r.private = resp.Private
+	}
+	return diags
+}
+
+func (r *testResource) validate(cfg tftypes.Value) []*tfprotov6.Diagnostic {
+	r.h.t.Helper()
+	resp, err := r.h.server.ValidateResourceConfig(r.h.ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: r.typeName,
+		Config:   r.h.dynamic(cfg),
+		ClientCapabilities: &tfprotov6.ValidateResourceConfigClientCapabilities{
+			WriteOnlyAttributesAllowed: true,
+		},
+	})
+	if err != nil {
+		r.h.t.Fatalf("%s ValidateResourceConfig: %s", r.typeName, err)
+	}
+	return resp.Diagnostics
+}
+
+func (r *testResource) plan(prior, cfg tftypes.Value) (*tfprotov6.PlanResourceChangeResponse, []*tfprotov6.Diagnostic) {
+	r.h.t.Helper()
+	proposed := proposedNewState(r.schema.Block, prior, cfg)
+	resp, err := r.h.server.PlanResourceChange(r.h.ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         r.typeName,
+		PriorState:       r.h.dynamic(prior),
+		ProposedNewState: r.h.dynamic(proposed),
+		Config:           r.h.dynamic(cfg),
+		PriorPrivate:     r.private,
+	})
+	if err != nil {
+		r.h.t.Fatalf("%s PlanResourceChange: %s", r.typeName, err)
+	}
+	return resp, resp.Diagnostics
+}
+
+// Refresh reads the instance back from the API.
+func (r *testResource) Refresh() {
+	r.h.t.Helper()
+	r.refresh()
+}
+
+// RefreshExpectWarning refreshes and requires a warning containing substr.
+func (r *testResource) RefreshExpectWarning(substr string) {
+	r.h.t.Helper()
+	diags := r.refresh()
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityWarning && strings.Contains(d.Summary+": "+d.Detail, substr) {
+			return
+		}
+	}
+	r.h.t.Fatalf("%s refresh: expected warning containing %q, got:%s", r.typeName, substr, formatDiags(diags))
+}
+
+func (r *testResource) refresh() []*tfprotov6.Diagnostic {
+	r.h.t.Helper()
+	if r.state.IsNull() {
+		return nil
+	}
+	resp, err := r.h.server.ReadResource(r.h.ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     r.typeName,
+		CurrentState: r.h.dynamic(r.state),
+		Private:      r.private,
+	})
+	if err != nil {
+		r.h.t.Fatalf("%s ReadResource: %s", r.typeName, err)
+	}
+	r.h.requireNoErrors(r.typeName+" read", resp.Diagnostics)
+	newState, err := resp.NewState.Unmarshal(r.schema.ValueType())
+	if err != nil {
+		r.h.t.Fatalf("%s: decoding refreshed state: %s", r.typeName, err)
+	}
+	r.state = newState
+	r.private = resp.Private
+	return resp.Diagnostics
+}
+
+// ExpectNoChanges requires that planning config against the current state is a no-op.
+func (r *testResource) ExpectNoChanges(config map[string]any) {
+	r.h.t.Helper()
+	cfg := r.h.configValue(r.schema.Block, config)
+	plan, diags := r.plan(r.state, cfg)
+	r.h.requireNoErrors(r.typeName+" plan", diags)
+	planned, err := plan.PlannedState.Unmarshal(r.schema.ValueType())
+	if err != nil {
+		r.h.t.Fatalf("%s: decoding planned state: %s", r.typeName, err)
+	}
+	if !planned.Equal(r.state) {
+		r.h.t.Fatalf("%s: expected empty plan after apply, got changes:\n%s", r.typeName, diffValues(r.state, planned))
+	}
+}
+
+// ExpectReplace requires that planning config against the current state replaces the instance.
+func (r *testResource) ExpectReplace(config map[string]any) {
+	r.h.t.Helper()
+	cfg := r.h.configValue(r.schema.Block, config)
+	plan, diags := r.plan(r.state, cfg)
+	r.h.requireNoErrors(r.typeName+" plan", diags)
+	if len(plan.RequiresReplace) == 0 {
+		r.h.t.Fatalf("%s: expected plan to require replacement", r.typeName)
+	}
+}
+
+// Destroy plans and applies deletion of the instance.
+func (r *testResource) Destroy() {
+	r.h.t.Helper()
+	if r.state.IsNull() {
+		return
+	}
+	typ := r.schema.ValueType()
+	null := tftypes.NewValue(typ, nil)
+
+	plan, diags := r.plan(r.state, null)
+	r.h.requireNoErrors(r.typeName+" destroy plan", diags)
+
+	resp, err := r.h.server.ApplyResourceChange(r.h.ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       r.typeName,
+		PriorState:     r.h.dynamic(r.state),
+		PlannedState:   plan.PlannedState,
+		Config:         r.h.dynamic(null),
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		r.h.t.Fatalf("%s ApplyResourceChange (destroy): %s", r.typeName, err)
+	}
+	r.h.requireNoErrors(r.typeName+" destroy", resp.Diagnostics)
+	r.state = null
+	r.private = nil
+}
+
+// Gone reports whether a refresh removed the instance from state.
+func (r *testResource) Gone() bool {
+	r.h.t.Helper()
+	r.Refresh()
+	return r.state.IsNull()
+}
+
+// ImportVerify imports id into a fresh instance and requires its state to
+// match this instance, apart from the ignored attributes.
+func (r *testResource) ImportVerify(id string, ignore ...string) *testResource {
+	r.h.t.Helper()
+	imported := r.h.Import(r.typeName, id)
+
+	skip := map[string]bool{}
+	for _, name := range ignore {
+		skip[name] = true
+	}
+	want, got := r.Attrs(), imported.Attrs()
+	names := make([]string, 0, len(want))
+	for name := range want {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if skip[name] {
+			continue
+		}
+		if !want[name].Equal(got[name]) {
+			r.h.t.Errorf("%s import %s: attribute %s: expected %s, got %s", r.typeName, id, name, want[name], got[name])
+		}
+	}
+	return imported
+}
+
+// Import imports id as a new instance of typeName and refreshes it.
+func (h *testHarness) Import(typeName, id string) *testResource {
+	h.t.Helper()
+	r := h.resource(typeName)
+	resp, err := h.server.ImportResourceState(h.ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+	if err != nil {
+		h.t.Fatalf("%s ImportResourceState: %s", typeName, err)
+	}
+	h.requireNoErrors(typeName+" import", resp.Diagnostics)
+	if len(resp.ImportedResources) != 1 {
+		h.t.Fatalf("%s import: expected 1 imported resource, got %d", typeName, len(resp.ImportedResources))
+	}
+	state, err := resp.ImportedResources[0].State.Unmarshal(r.schema.ValueType())
+	if err != nil {
+		h.t.Fatalf("%s: decoding imported state: %s", typeName, err)
+	}
+	r.state = state
+	r.private = resp.ImportedResources[0].Private
+	r.Refresh()
+	if r.state.IsNull() {
+		h.t.Fatalf("%s import %s: resource not found after import", typeName, id)
+	}
+	return r
+}
+
+// ImportExpectError requires importing id as typeName to fail with an error containing substr.
+func (h *testHarness) ImportExpectError(typeName, id, substr string) {
+	h.t.Helper()
+	resp, err := h.server.ImportResourceState(h.ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+	if err != nil {
+		h.t.Fatalf("%s ImportResourceState: %s", typeName, err)
+	}
+	if !hasErrors(resp.Diagnostics) || !strings.Contains(formatDiags(resp.Diagnostics), substr) {
+		h.t.Fatalf("%s import %s: expected error containing %q, got: %s", typeName, id, substr, formatDiags(resp.Diagnostics))
+	}
+}
+
+// UpgradeState returns an instance of typeName whose state is rawState, a
+// state written with schema version, upgraded to the current schema and
+// refreshed.
+func (h *testHarness) UpgradeState(typeName string, version int64, rawState map[string]any) *testResource {
+	h.t.Helper()
+	r := h.resource(typeName)
+	raw, err := json.Marshal(rawState)
+	if err != nil {
+		h.t.Fatalf("encoding raw state: %s", err)
+	}
+	resp, err := h.server.UpgradeResourceState(h.ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: raw},
+	})
+	if err != nil {
+		h.t.Fatalf("%s UpgradeResourceState: %s", typeName, err)
+	}
+	h.requireNoErrors(typeName+" upgrade state", resp.Diagnostics)
+	state, err := resp.UpgradedState.Unmarshal(r.schema.ValueType())
+	if err != nil {
+		h.t.Fatalf("%s: decoding upgraded state: %s", typeName, err)
+	}
+	r.state = state
+	r.Refresh()
+	return r
+}
+
+// Attrs returns the top-level attributes of the current state.
+func (r *testResource) Attrs() map[string]tftypes.Value {
+	r.h.t.Helper()
+	return objectAttrs(r.h.t, r.state)
+}
+
+// Attr returns a top-level attribute of the current state rendered as a
+// string: strings as-is, numbers and bools formatted, null as "".
+func (r *testResource) Attr(name string) string {
+	r.h.t.Helper()
+	return valueString(r.h.t, r.Attrs()[name])
+}
+
+// ReadDataSource reads typeName with config and returns its attributes.
+func (h *testHarness) ReadDataSource(typeName string, config map[string]any) map[string]tftypes.Value {
+	h.t.Helper()
+	attrs, diags := h.readDataSource(typeName, config)
+	h.requireNoErrors(typeName+" read", diags)
+	return attrs
+}
+
+// ReadDataSourceExpectError requires reading typeName with config to fail with an error containing substr.
+func (h *testHarness) ReadDataSourceExpectError(typeName string, config map[string]any, substr string) {
+	h.t.Helper()
+	_, diags := h.readDataSource(typeName, config)
+	if !hasErrors(diags) || !strings.Contains(formatDiags(diags), substr) {
+		h.t.Fatalf("%s read: expected error containing %q, got: %s", typeName, substr, formatDiags(diags))
+	}
+}
+
+func (h *testHarness) readDataSource(typeName string, config map[string]any) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	h.t.Helper()
+	s, ok := h.schemas.DataSourceSchemas[typeName]
+	if !ok {
+		h.t.Fatalf("unknown data source type %s", typeName)
+	}
+	cfg := h.dynamic(h.configValue(s.Block, config))
+
+	vresp, err := h.server.ValidateDataResourceConfig(h.ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+		TypeName: typeName,
+		Config:   cfg,
+	})
+	if err != nil {
+		h.t.Fatalf("%s ValidateDataResourceConfig: %s", typeName, err)
+	}
+	if hasErrors(vresp.Diagnostics) {
+		return nil, vresp.Diagnostics
+	}
+
+	resp, err := h.server.ReadDataSource(h.ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName: typeName,
+		Config:   cfg,
+	})
+	if err != nil {
+		h.t.Fatalf("%s ReadDataSource: %s", typeName, err)
+	}
+	if hasErrors(resp.Diagnostics) {
+		return nil, resp.Diagnostics
+	}
+	state, err := resp.State.Unmarshal(s.ValueType())
+	if err != nil {
+		h.t.Fatalf("%s: decoding state: %s", typeName, err)
+	}
+	return objectAttrs(h.t, state), resp.Diagnostics
+}
+
+// OpenEphemeralResource opens the ephemeral resource typeName with config and
+// returns its result, which is never persisted by Terraform.
+func (h *testHarness) OpenEphemeralResource(typeName string, config map[string]any) map[string]tftypes.Value {
+	h.t.Helper()
+	attrs, diags := h.openEphemeralResource(typeName, config)
+	h.requireNoErrors(typeName+" open", diags)
+	return attrs
+}
+
+// OpenEphemeralResourceExpectError requires opening typeName with config to fail with an error containing substr.
+func (h *testHarness) OpenEphemeralResourceExpectError(typeName string, config map[string]any, substr string) {
+	h.t.Helper()
+	_, diags := h.openEphemeralResource(typeName, config)
+	if !hasErrors(diags) || !strings.Contains(formatDiags(diags), substr) {
+		h.t.Fatalf("%s open: expected error containing %q, got: %s", typeName, substr, formatDiags(diags))
+	}
+}
+
+func (h *testHarness) openEphemeralResource(typeName string, config map[string]any) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	h.t.Helper()
+	s, ok := h.schemas.EphemeralResourceSchemas[typeName]
+	if !ok {
+		h.t.Fatalf("unknown ephemeral resource type %s", typeName)
+	}
+	cfg := h.dynamic(h.configValue(s.Block, config))
+
+	vresp, err := h.server.ValidateEphemeralResourceConfig(h.ctx, &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		TypeName: typeName,
+		Config:   cfg,
+	})
+	if err != nil {
+		h.t.Fatalf("%s ValidateEphemeralResourceConfig: %s", typeName, err)
+	}
+	if hasErrors(vresp.Diagnostics) {
+		return nil, vresp.Diagnostics
+	}
+
+	resp, err := h.server.OpenEphemeralResource(h.ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   cfg,
+	})
+	if err != nil {
+		h.t.Fatalf("%s OpenEphemeralResource: %s", typeName, err)
+	}
+	if hasErrors(resp.Diagnostics) {
+		return nil, resp.Diagnostics
+	}
+	result, err := resp.Result.Unmarshal(s.ValueType())
+	if err != nil {
+		h.t.Fatalf("%s: decoding result: %s", typeName, err)
+	}
+	return objectAttrs(h.t, result), resp.Diagnostics
+}
+
+// CallFunction calls the provider function name with args. It returns the
+// result, or the text of the function error if the call failed.
+func (h *testHarness) CallFunction(name string, args ...any) (tftypes.Value, string) {
+	h.t.Helper()
+	fn, ok := h.schemas.Functions[name]
+	if !ok {
+		h.t.Fatalf("unknown function %s", name)
+	}
+	if len(args) != len(fn.Parameters) {
+		h.t.Fatalf("%s: got %d arguments, want %d", name, len(args), len(fn.Parameters))
+	}
+	dvs := make([]*tfprotov6.DynamicValue, len(args))
+	for i, a := range args {
+		dvs[i] = h.dynamic(toValue(h.t, fn.Parameters[i].Type, a))
+	}
+
+	resp, err := h.server.CallFunction(h.ctx, &tfprotov6.CallFunctionRequest{Name: name, Arguments: dvs})
+	if err != nil {
+		h.t.Fatalf("%s CallFunction: %s", name, err)
+	}
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error.Text
+	}
+	result, err := resp.Result.Unmarshal(fn.Return.Type)
+	if err != nil {
+		h.t.Fatalf("%s: decoding result: %s", name, err)
+	}
+	return result, ""
+}
+
+func (h *testHarness) dynamic(v tftypes.Value) *tfprotov6.DynamicValue {
+	h.t.Helper()
+	dv, err := tfprotov6.NewDynamicValue(v.Type(), v)
+	if err != nil {
+		h.t.Fatalf("encoding value: %s", err)
+	}
+	return &dv
+}
+
+func (h *testHarness) requireNoErrors(op string, diags []*tfprotov6.Diagnostic) {
+	h.t.Helper()
+	if hasErrors(diags) {
+		h.t.Fatalf("%s: %s", op, formatDiags(diags))
+	}
+}
+
+func hasErrors(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func formatDiags(diags []*tfprotov6.Diagnostic) string {
+	var b strings.Builder
+	for _, d := range diags {
+		sev := "warning"
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			sev = "error"
+		}
+		fmt.Fprintf(&b, "\n  %s: %s: %s", sev, d.Summary, d.Detail)
+		if d.Attribute != nil {
+			fmt.Fprintf(&b, " (at %s)", d.Attribute)
+		}
+	}
+	return b.String()
+}
+
+// configValue builds a configuration object for block from attrs, filling
+// anything not given with null (attributes and single blocks) or an empty
+// collection (list and set blocks), as Terraform does.
+func (h *testHarness) configValue(block *tfprotov6.SchemaBlock, attrs map[string]any) tftypes.Value {
+	h.t.Helper()
+	typ := block.ValueType().(tftypes.Object)
+
+	known := map[string]bool{}
+	vals := map[string]tftypes.Value{}
+	for _, a := range block.Attributes {
+		known[a.Name] = true
+		vals[a.Name] = toValue(h.t, typ.AttributeTypes[a.Name], attrs[a.Name])
+	}
+	for _, b := range block.BlockTypes {
+		known[b.TypeName] = true
+		attrType := typ.AttributeTypes[b.TypeName]
+		raw, set := attrs[b.TypeName]
+		switch b.Nesting {
+		case tfprotov6.SchemaNestedBlockNestingModeSingle, tfprotov6.SchemaNestedBlockNestingModeGroup:
+			if !set || raw == nil {
+				vals[b.TypeName] = tftypes.NewValue(attrType, nil)
+				continue
+			}
+			vals[b.TypeName] = h.configValue(b.Block, raw.(map[string]any))
+		default:
+			var elems []tftypes.Value
+			if set {
+				for _, e := range raw.([]map[string]any) {
+					elems = append(elems, h.configValue(b.Block, e))
+				}
+			}
+			vals[b.TypeName] = tftypes.NewValue(attrType, elems)
+		}
+	}
+	for name := range attrs {
+		if !known[name] {
+			h.t.Fatalf("config: unknown attribute %q", name)
+		}
+	}
+	return tftypes.NewValue(typ, vals)
+}
+
+// toValue converts a plain Go value into a tftypes.Value of type typ.
+func toValue(t *testing.T, typ tftypes.Type, v any) tftypes.Value {
+	t.Helper()
+	if v == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+	if tv, ok := v.(tftypes.Value); ok {
+		return tv
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, v.(string))
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, v.(bool))
+	case typ.Is(tftypes.Number):
+		switch n := v.(type) {
+		case int:
+			return tftypes.NewValue(typ, big.NewFloat(float64(n)))
+		case int64:
+			return tftypes.NewValue(typ, big.NewFloat(float64(n)))
+		case float64:
+			return tftypes.NewValue(typ, big.NewFloat(n))
+		}
+	case typ.Is(tftypes.DynamicPseudoType):
+		return tftypes.NewValue(tftypes.String, fmt.Sprint(v))
+	}
+
+	switch tt := typ.(type) {
+	case tftypes.List:
+		return tftypes.NewValue(typ, toElems(t, tt.ElementType, v))
+	case tftypes.Set:
+		return tftypes.NewValue(typ, toElems(t, tt.ElementType, v))
+	case tftypes.Map:
+		out := map[string]tftypes.Value{}
+		switch m := v.(type) {
+		case map[string]string:
+			for k, e := range m {
+				out[k] = toValue(t, tt.ElementType, e)
+			}
+		case map[string]any:
+			for k, e := range m {
+				out[k] = toValue(t, tt.ElementType, e)
+			}
+		}
+		return tftypes.NewValue(typ, out)
+	case tftypes.Object:
+		m := v.(map[string]any)
+		out := map[string]tftypes.Value{}
+		for name, at := range tt.AttributeTypes {
+			out[name] = toValue(t, at, m[name])
+		}
+		return tftypes.NewValue(typ, out)
+	}
+
+	t.Fatalf("config: cannot convert %T to %s", v, typ)
+	return tftypes.Value{}
+}
+
+func toElems(t *testing.T, elem tftypes.Type, v any) []tftypes.Value {
+	t.Helper()
+	var out []tftypes.Value
+	switch s := v.(type) {
+	case []string:
+		for _, e := range s {
+			out = append(out, toValue(t, elem, e))
+		}
+	case []any:
+		for _, e := range s {
+			out = append(out, toValue(t, elem, e))
+		}
+	case []map[string]any:
+		for _, e := range s {
+			out = append(out, toValue(t, elem, e))
+		}
+	default:
+		t.Fatalf("config: cannot convert %T to a collection", v)
+	}
+	return out
+}
+
+// proposedNewState approximates Terraform's proposed new state: configured
+// values win, computed attributes left null in config keep their prior
+// value, and write-only attributes are always null.
+func proposedNewState(block *tfprotov6.SchemaBlock, prior, cfg tftypes.Value) tftypes.Value {
+	if cfg.IsNull() {
+		return cfg
+	}
+
+	var priorAttrs, cfgAttrs map[string]tftypes.Value
+	_ = prior.As(&priorAttrs)
+	_ = cfg.As(&cfgAttrs)
+
+	out := map[string]tftypes.Value{}
+	for _, a := range block.Attributes {
+		c := cfgAttrs[a.Name]
+		if a.WriteOnly {
+			out[a.Name] = tftypes.NewValue(c.Type(), nil)
+			continue
+		}
+		if c.IsNull() && a.Computed && !prior.IsNull() {
+			out[a.Name] = priorAttrs[a.Name]
+			continue
+		}
+		out[a.Name] = c
+	}
+	for _, b := range block.BlockTypes {
+		c := cfgAttrs[b.TypeName]
+		if b.Nesting == tfprotov6.SchemaNestedBlockNestingModeSingle {
+			out[b.TypeName] = proposedNewState(b.Block, priorAttrs[b.TypeName], c)
+			continue
+		}
+		out[b.TypeName] = c
+	}
+	return tftypes.NewValue(cfg.Type(), out)
+}
+
+func objectAttrs(t *testing.T, v tftypes.Value) map[string]tftypes.Value {
+	t.Helper()
+	out := map[string]tftypes.Value{}
+	if v.IsNull() {
+		return out
+	}
+	if err := v.As(&out); err != nil {
+		t.Fatalf("decoding object: %s", err)
+	}
+	return out
+}
+
+// valueString renders a primitive value for comparisons in tests.
+func valueString(t *testing.T, v tftypes.Value) string {
+	t.Helper()
+	if v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return s
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return fmt.Sprint(b)
+	case v.Type().Is(tftypes.Number):
+		var f big.Float
+		_ = v.As(&f)
+		return f.Text('f', -1)
+	}
+	t.Fatalf("valueString: unsupported type %s", v.Type())
+	return ""
+}
+
+// stringElems returns the string elements of a list or set value, sorted.
+func stringElems(t *testing.T, v tftypes.Value) []string {
+	t.Helper()
+	var elems []tftypes.Value
+	if v.Type() == nil {
+		t.Fatalf("%s: no such attribute", "stringElems")
+	}
+	if v.IsNull() {
+		return nil
+	}
+	if err := v.As(&elems); err != nil {
+		t.Fatalf("decoding collection: %s", err)
+	}
+	out := make([]string, 0, len(elems))
+	for _, e := range elems {
+		out = append(out, valueString(t, e))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// objectElems returns the attributes of each object in a list or set value.
+func objectElems(t *testing.T, v tftypes.Value) []map[string]tftypes.Value {
+	t.Helper()
+	var elems []tftypes.Value
+	if v.Type() == nil {
+		t.Fatalf("%s: no such attribute", "objectElems")
+	}
+	if v.IsNull() {
+		return nil
+	}
+	if err := v.As(&elems); err != nil {
+		t.Fatalf("decoding collection: %s", err)
+	}
+	out := make([]map[string]tftypes.Value, 0, len(elems))
+	for _, e := range elems {
+		out = append(out, objectAttrs(t, e))
+	}
+	return out
+}
+
+func diffValues(before, after tftypes.Value) string {
+	diffs, err := before.Diff(after)
+	if err != nil {
+		return err.Error()
+	}
+	var b strings.Builder
+	for _, d := range diffs {
+		if d.Value1 == nil || d.Value2 == nil {
+			continue
+		}
+		if d.Value1.Type().Is(tftypes.Object{}) || d.Value1.Type().Is(tftypes.List{}) || d.Value1.Type().Is(tftypes.Set{}) || d.Value1.Type().Is(tftypes.Map{}) {
+			continue
+		}
+		fmt.Fprintf(&b, "  %s: %s => %s\n", d.Path, d.Value1, d.Value2)
+	}
+	return b.String()
+}
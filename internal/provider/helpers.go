@@ -12,3 +12,12 @@ func stringPointerFromAttr(v types.String) *string {
 	}
 	return &s
 }
+
+// stringValueOrNull maps the empty string the API returns for unset optional
+// fields to null, so omitting the attribute in config doesn't produce a diff.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
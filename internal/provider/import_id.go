@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importIDPart is one "/"-separated part of a composite import ID: the
+// attribute it sets and how it's shown in the expected format.
+type importIDPart struct {
+	Attr  string
+	Label string
+}
+
+// compositeImportID describes a resource imported by a composite ID.
+type compositeImportID struct {
+	Parts []importIDPart
+	// OrgPrefix is set for org-scoped resources, whose ID may be prefixed
+	// with "<org_id>/" to import from another of the caller's organizations
+	// (see splitImportOrg).
+	OrgPrefix bool
+}
+
+// compositeImportIDs lists the resources imported by a composite ID and the
+// parts of their IDs, in order. Shared by ImportState and the parse_import_id
+// function.
+var compositeImportIDs = map[string]compositeImportID{
+	"autoglue_cluster_metadata": {Parts: []importIDPart{{"cluster_id", "cluster_id"}, {"id", "metadata_id"}}, OrgPrefix: true},
+	"autoglue_org_api_key":      {Parts: []importIDPart{{"org_id", "org_id"}, {"id", "key_id"}}},
+	"autoglue_org_member":       {Parts: []importIDPart{{"org_id", "org_id"}, {"user_id", "user_id"}}},
+}
+
+// importIDFormat renders the expected import ID format, e.g. <org_id>/<key_id>.
+func importIDFormat(c compositeImportID) string {
+	labels := make([]string, 0, len(c.Parts))
+	for _, p := range c.Parts {
+		labels = append(labels, "<"+p.Label+">")
+	}
+	format := strings.Join(labels, "/")
+	if c.OrgPrefix {
+		format += " or <org_id>/" + format
+	}
+	return format
+}
+
+// parseImportID splits the composite import ID of resourceType into the
+// attributes it sets, including org_id if the ID has an "<org_id>/" prefix.
+func parseImportID(resourceType, id string) (map[string]string, error) {
+	c, ok := compositeImportIDs[resourceType]
+	if !ok {
+		names := make([]string, 0, len(compositeImportIDs))
+		for t := range compositeImportIDs {
+			names = append(names, t)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%s is not imported by a composite ID; supported resource types: %s",
+			resourceType, strings.Join(names, ", "))
+	}
+
+	out := make(map[string]string, len(c.Parts)+1)
+	if c.OrgPrefix {
+		orgID, rest := splitImportOrg(id, len(c.Parts))
+		if rest != id {
+			if orgID == "" {
+				return nil, fmt.Errorf("expected format: %s", importIDFormat(c))
+			}
+			out["org_id"], id = orgID, rest
+		}
+	}
+	values := strings.Split(id, "/")
+	if len(values) != len(c.Parts) {
+		return nil, fmt.Errorf("expected format: %s", importIDFormat(c))
+	}
+	for i, p := range c.Parts {
+		if values[i] == "" {
+			return nil, fmt.Errorf("expected format: %s", importIDFormat(c))
+		}
+		out[p.Attr] = values[i]
+	}
+	return out, nil
+}
+
+// importStateCompositeID imports resourceType from its composite ID.
+func importStateCompositeID(ctx context.Context, resourceType string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	attrs, err := parseImportID(resourceType, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format: "+importIDFormat(compositeImportIDs[resourceType]),
+		)
+		return
+	}
+
+	for name, value := range attrs {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importStatePassthroughIDWithOrg is resource.ImportStatePassthroughID for
+// org-scoped resources: the ID may be prefixed with "<org_id>/" to import an
+// object from another of the caller's organizations.
+func importStatePassthroughIDWithOrg(ctx context.Context, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := splitImportOrg(req.ID, 1)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
+	}
+	req.ID = id
+	resource.ImportStatePassthroughID(ctx, attrPath, req, resp)
+}
@@ -29,6 +29,7 @@ type labelResourceModel struct {
 	Key            types.String `tfsdk:"key"`
 	Value          types.String `tfsdk:"value"`
 	OrganizationID types.String `tfsdk:"organization_id"`
+	OrgID          types.String `tfsdk:"org_id"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
 }
@@ -60,6 +61,7 @@ func (r *labelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "Label value.",
 			},
+			"org_id": orgIDResourceAttribute(),
 			"organization_id": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -106,6 +108,11 @@ func (r *labelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := createLabelPayload{
 		Key:   plan.Key.ValueString(),
 		Value: plan.Value.ValueString(),
@@ -141,6 +148,11 @@ func (r *labelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Label ID is required in state to read label.")
@@ -187,6 +199,11 @@ func (r *labelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Label ID is required in state to update label.")
@@ -230,6 +247,11 @@ func (r *labelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		// Nothing to do
@@ -248,8 +270,8 @@ func (r *labelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *labelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_label.example <label_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_label.example <label_id> or <org_id>/<label_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
 }
 
 func mapLabelToModel(m *labelResourceModel, l *label) {
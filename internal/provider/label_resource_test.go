@@ -0,0 +1,47 @@
+package provider
+
+import "testing"
+
+func TestLabelResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	label := h.resource("autoglue_label")
+	label.Apply(map[string]any{"key": "tier", "value": "web"})
+	id := label.Attr("id")
+
+	label.Apply(map[string]any{"key": "tier", "value": "api"})
+	if label.Attr("id") != id || api.labels[id].Value != "api" {
+		t.Errorf("label not updated in place: %+v", api.labels[id])
+	}
+
+	label.ImportVerify(id)
+
+	other := h.resource("autoglue_label")
+	other.Apply(map[string]any{"key": "Tier", "value": "api"})
+
+	list := h.ReadDataSource("autoglue_labels", nil)
+	if got := len(objectElems(t, list["labels"])); got != 2 {
+		t.Errorf("labels data source returned %d, want 2", got)
+	}
+
+	// The fake ignores label filters, so this exercises the client-side pass.
+	list = h.ReadDataSource("autoglue_labels", map[string]any{"key": "tier", "value": "web"})
+	if got := len(objectElems(t, list["labels"])); got != 0 {
+		t.Errorf("filtered labels data source returned %d, want 0", got)
+	}
+
+	// Exact filters are case-sensitive: keys that differ only in case are
+	// different labels.
+	list = h.ReadDataSource("autoglue_labels", map[string]any{"key": "tier"})
+	labels := objectElems(t, list["labels"])
+	if len(labels) != 1 || valueString(t, labels[0]["key"]) != "tier" {
+		t.Errorf("labels filtered by key tier = %v, want only the tier label", labels)
+	}
+
+	other.Destroy()
+	label.Destroy()
+	if len(api.labels) != 0 {
+		t.Errorf("expected label to be deleted")
+	}
+}
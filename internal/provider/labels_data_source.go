@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,7 +21,13 @@ type labelsDataSource struct {
 }
 
 type labelsDataSourceModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+	Q     types.String `tfsdk:"q"`
+
 	Labels []labelDataModel `tfsdk:"labels"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type labelDataModel struct {
@@ -43,11 +49,23 @@ func (d *labelsDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 func (d *labelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Lists all labels in the organization.",
+		Description: "Lists labels in the organization, optionally filtered by key, value, or query string.",
 		Attributes: map[string]dsschema.Attribute{
+			"key": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Exact label key to filter by.",
+			},
+			"value": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Exact label value to filter by.",
+			},
+			"q": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Case-insensitive substring match on key.",
+			},
 			"labels": dsschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "All labels visible to the organization.",
+				Description: "Matching labels.",
 				NestedObject: dsschema.NestedAttributeObject{
 					Attributes: map[string]dsschema.Attribute{
 						"id": dsschema.StringAttribute{
@@ -77,6 +95,7 @@ func (d *labelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -105,17 +124,37 @@ func (d *labelsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	var state labelsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := url.Values{}
+	setQueryFilter(q, "key", state.Key)
+	setQueryFilter(q, "value", state.Value)
+	setQueryFilter(q, "q", state.Q)
 
-	tflog.Info(ctx, "Listing Autoglue labels")
+	tflog.Info(ctx, "Listing Autoglue labels", map[string]any{
+		"query": q.Encode(),
+	})
 
-	var apiResp []label
-	if err := d.client.doJSON(ctx, http.MethodGet, "/labels", "", nil, &apiResp); err != nil {
+	apiResp, err := listAll[label](ctx, d.client, "/labels", q)
+	if err != nil {
 		resp.Diagnostics.AddError("Error listing labels", err.Error())
 		return
 	}
 
 	state.Labels = make([]labelDataModel, 0, len(apiResp))
 	for _, l := range apiResp {
+		if !matchesExact(state.Key, l.Key) || !matchesExact(state.Value, l.Value) {
+			continue
+		}
 		state.Labels = append(state.Labels, labelDataModel{
 			ID:             types.StringValue(l.ID),
 			Key:            types.StringValue(l.Key),
@@ -126,6 +165,6 @@ func (d *labelsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		})
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// List data sources push their filter arguments to the API as query
+// parameters and then re-apply them to the results, so a filter the endpoint
+// doesn't support (or silently ignores) still narrows the list.
+
+// filterValue returns the trimmed filter value, or "" when the attribute is unset.
+func filterValue(v types.String) string {
+	if v.IsNull() || v.IsUnknown() {
+		return ""
+	}
+	return strings.TrimSpace(v.ValueString())
+}
+
+// setQueryFilter sets key on q when the filter attribute v is set.
+func setQueryFilter(q url.Values, key string, v types.String) {
+	if s := filterValue(v); s != "" {
+		q.Set(key, s)
+	}
+}
+
+// matchesExact reports whether got equals the filter. Keys and values are
+// case-sensitive, so the comparison is too. An unset filter matches everything.
+func matchesExact(filter types.String, got string) bool {
+	want := filterValue(filter)
+	return want == "" || want == got
+}
+
+// matchesPrefix reports whether got starts with the filter, ignoring case.
+func matchesPrefix(filter types.String, got string) bool {
+	want := filterValue(filter)
+	return want == "" || strings.HasPrefix(strings.ToLower(got), strings.ToLower(want))
+}
+
+// matchesSubstring reports whether any of fields contains the filter, ignoring case.
+func matchesSubstring(filter types.String, fields ...string) bool {
+	want := strings.ToLower(filterValue(filter))
+	if want == "" {
+		return true
+	}
+	for _, f := range fields {
+		if strings.Contains(strings.ToLower(f), want) {
+			return true
+		}
+	}
+	return false
+}
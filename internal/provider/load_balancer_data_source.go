@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &loadBalancerDataSource{}
+	_ datasource.DataSourceWithConfigure        = &loadBalancerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &loadBalancerDataSource{}
+)
+
+type loadBalancerDataSource struct {
+	client *autoglueClient
+}
+
+type loadBalancerDataSourceModel struct {
+	loadBalancerListItemModel
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func NewLoadBalancerDataSource() datasource.DataSource {
+	return &loadBalancerDataSource{}
+}
+
+func (d *loadBalancerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer"
+}
+
+func (d *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Reads a single Autoglue load balancer by ID or name.",
+		Attributes: lookupAttributes(loadBalancerDataSourceAttributes(), map[string]string{
+			"id":   "Load balancer ID to look up. Exactly one of `id` or `name` must be set.",
+			"name": "Load balancer name to look up. Must match exactly one load balancer.",
+		}),
+	}
+}
+
+func (d *loadBalancerDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *loadBalancerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config loadBalancerDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found loadBalancer
+	if id := filterValue(config.ID); id != "" {
+		tflog.Info(ctx, "Reading Autoglue load balancer data source", map[string]any{"id": id})
+
+		apiPath := fmt.Sprintf("/load-balancers/%s", id)
+		if err := d.client.doJSON(ctx, http.MethodGet, apiPath, "", nil, &found); err != nil {
+			if isNotFound(err) {
+				err = fmt.Errorf("no load balancer with id %q found", id)
+			}
+			resp.Diagnostics.AddError("Error reading load balancer", err.Error())
+			return
+		}
+	} else {
+		name := filterValue(config.Name)
+		tflog.Info(ctx, "Looking up Autoglue load balancer by name", map[string]any{"name": name})
+
+		items, err := listAll[loadBalancer](ctx, d.client, "/load-balancers", url.Values{"name": {name}})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing load balancers", err.Error())
+			return
+		}
+		found, err = findOne(items,
+			func(v loadBalancer) bool { return matchesExact(config.Name, v.Name) },
+			func(v loadBalancer) string { return v.ID },
+			"load balancer", fmt.Sprintf("name %q", name))
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up load balancer", err.Error())
+			return
+		}
+	}
+
+	state := loadBalancerDataSourceModel{loadBalancerDataSourceItem(found), config.OrgID}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
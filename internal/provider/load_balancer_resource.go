@@ -29,6 +29,7 @@ type loadBalancerResource struct {
 type loadBalancerResourceModel struct {
 	ID               types.String `tfsdk:"id"`
 	OrganizationID   types.String `tfsdk:"organization_id"`
+	OrgID            types.String `tfsdk:"org_id"`
 	Name             types.String `tfsdk:"name"`
 	Kind             types.String `tfsdk:"kind"`
 	PublicIPAddress  types.String `tfsdk:"public_ip_address"`
@@ -57,6 +58,7 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 
+			"org_id": orgIDResourceAttribute(),
 			"organization_id": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -142,6 +144,11 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := createLoadBalancerPayload{
 		Name:             plan.Name.ValueString(),
 		Kind:             plan.Kind.ValueString(),
@@ -180,6 +187,11 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.State.RemoveResource(ctx)
@@ -224,6 +236,11 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Load balancer ID is required in state to update.")
@@ -280,6 +297,11 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		return
@@ -297,8 +319,8 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *loadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_load_balancer.example <load_balancer_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_load_balancer.example <load_balancer_id> or <org_id>/<load_balancer_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
 }
 
 func syncLoadBalancerFromAPI(state *loadBalancerResourceModel, api *loadBalancer) {
@@ -0,0 +1,47 @@
+package provider
+
+import "testing"
+
+func TestLoadBalancerResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	lb := h.resource("autoglue_load_balancer")
+	config := map[string]any{
+		"name":               "apps",
+		"kind":               "public",
+		"public_ip_address":  "203.0.113.20",
+		"private_ip_address": "10.0.0.20",
+	}
+	lb.Apply(config)
+	id := lb.Attr("id")
+
+	config["kind"] = "glueops"
+	config["name"] = "control-plane"
+	lb.Apply(config)
+	if lb.Attr("id") != id || api.loadBalancers[id].Kind != "glueops" {
+		t.Errorf("load balancer not updated in place: %+v", api.loadBalancers[id])
+	}
+
+	lb.ImportVerify(id)
+
+	list := h.ReadDataSource("autoglue_load_balancers", map[string]any{"kind": "glueops"})
+	if got := len(objectElems(t, list["load_balancers"])); got != 1 {
+		t.Errorf("load_balancers data source returned %d, want 1", got)
+	}
+	list = h.ReadDataSource("autoglue_load_balancers", map[string]any{"kind": "public"})
+	if got := len(objectElems(t, list["load_balancers"])); got != 0 {
+		t.Errorf("load_balancers kind filter returned %d, want 0", got)
+	}
+
+	byName := h.ReadDataSource("autoglue_load_balancer", map[string]any{"name": "control-plane"})
+	if got := valueString(t, byName["id"]); got != id {
+		t.Errorf("load_balancer data source by name returned id %q, want %q", got, id)
+	}
+	h.ReadDataSourceExpectError("autoglue_load_balancer", map[string]any{"name": "apps"}, `no load balancer with name "apps" found`)
+
+	lb.Destroy()
+	if len(api.loadBalancers) != 0 {
+		t.Errorf("expected load balancer to be deleted")
+	}
+}
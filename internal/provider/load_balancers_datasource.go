@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strings"
 
@@ -28,6 +27,8 @@ type loadBalancersDataSourceModel struct {
 	Search types.String `tfsdk:"search"`
 
 	LoadBalancers []loadBalancerListItemModel `tfsdk:"load_balancers"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type loadBalancerListItemModel struct {
@@ -71,42 +72,48 @@ func (d *loadBalancersDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Computed:    true,
 				Description: "Matching load balancers.",
 				NestedObject: dsschema.NestedAttributeObject{
-					Attributes: map[string]dsschema.Attribute{
-						"id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Load balancer ID.",
-						},
-						"organization_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Owning organization UUID.",
-						},
-						"name": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Load balancer name.",
-						},
-						"kind": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Load balancer kind.",
-						},
-						"public_ip_address": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Public IPv4 address.",
-						},
-						"private_ip_address": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Private IPv4 address.",
-						},
-						"created_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Creation timestamp.",
-						},
-						"updated_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last update timestamp.",
-						},
-					},
+					Attributes: loadBalancerDataSourceAttributes(),
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// loadBalancerDataSourceAttributes describes a load balancer as exposed by the load_balancer and load_balancers data sources.
+func loadBalancerDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Load balancer ID.",
+		},
+		"organization_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Owning organization UUID.",
+		},
+		"name": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Load balancer name.",
+		},
+		"kind": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Load balancer kind.",
+		},
+		"public_ip_address": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Public IPv4 address.",
+		},
+		"private_ip_address": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Private IPv4 address.",
+		},
+		"created_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Creation timestamp.",
+		},
+		"updated_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last update timestamp.",
 		},
 	}
 }
@@ -139,6 +146,11 @@ func (d *loadBalancersDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	q := url.Values{}
 	if !config.Kind.IsNull() && !config.Kind.IsUnknown() {
 		q.Set("kind", strings.TrimSpace(strings.ToLower(config.Kind.ValueString())))
@@ -155,26 +167,36 @@ func (d *loadBalancersDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	tflog.Info(ctx, "Listing Autoglue load balancers", map[string]any{"query": query})
 
-	var apiResp []loadBalancer
-	if err := d.client.doJSON(ctx, http.MethodGet, apiPath, query, nil, &apiResp); err != nil {
+	apiResp, err := listAll[loadBalancer](ctx, d.client, apiPath, q)
+	if err != nil {
 		resp.Diagnostics.AddError("Error listing load balancers", err.Error())
 		return
 	}
 
 	config.LoadBalancers = make([]loadBalancerListItemModel, 0, len(apiResp))
 	for _, lb := range apiResp {
-		config.LoadBalancers = append(config.LoadBalancers, loadBalancerListItemModel{
-			ID:               types.StringValue(lb.ID),
-			OrganizationID:   types.StringValue(lb.OrganizationID),
-			Name:             types.StringValue(lb.Name),
-			Kind:             types.StringValue(lb.Kind),
-			PublicIPAddress:  types.StringValue(lb.PublicIPAddress),
-			PrivateIPAddress: types.StringValue(lb.PrivateIPAddress),
-			CreatedAt:        types.StringValue(lb.CreatedAt),
-			UpdatedAt:        types.StringValue(lb.UpdatedAt),
-		})
+		if !matchesExact(config.Kind, lb.Kind) ||
+			!matchesExact(config.Name, lb.Name) ||
+			!matchesSubstring(config.Search, lb.Name) {
+			continue
+		}
+		config.LoadBalancers = append(config.LoadBalancers, loadBalancerDataSourceItem(lb))
 	}
 
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 }
+
+// loadBalancerDataSourceItem converts an API load balancer into its data source representation.
+func loadBalancerDataSourceItem(lb loadBalancer) loadBalancerListItemModel {
+	return loadBalancerListItemModel{
+		ID:               types.StringValue(lb.ID),
+		OrganizationID:   types.StringValue(lb.OrganizationID),
+		Name:             types.StringValue(lb.Name),
+		Kind:             types.StringValue(lb.Kind),
+		PublicIPAddress:  types.StringValue(lb.PublicIPAddress),
+		PrivateIPAddress: types.StringValue(lb.PrivateIPAddress),
+		CreatedAt:        types.StringValue(lb.CreatedAt),
+		UpdatedAt:        types.StringValue(lb.UpdatedAt),
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Singular data sources reuse their list counterpart's item schema and model.
+// The attributes they can be looked up by become optional arguments; every
+// other attribute stays computed. Their models embed the item model and add
+// the org_id argument.
+
+// lookupAttributes makes each attribute named in descriptions an optional
+// (and still computed) argument with the given description, and adds org_id.
+func lookupAttributes(attrs map[string]dsschema.Attribute, descriptions map[string]string) map[string]dsschema.Attribute {
+	for name, desc := range descriptions {
+		a, ok := attrs[name].(dsschema.StringAttribute)
+		if !ok {
+			panic(fmt.Sprintf("lookup attribute %q is not a string attribute", name))
+		}
+		a.Optional = true
+		a.Description = desc
+		attrs[name] = a
+	}
+	attrs["org_id"] = orgIDDataSourceAttribute()
+	return attrs
+}
+
+// findOne returns the only item accepted by match. what names the object type
+// and by the lookup (e.g. `hostname "worker-1"`), for error messages.
+func findOne[T any](items []T, match func(T) bool, id func(T) string, what, by string) (T, error) {
+	var found []T
+	for _, item := range items {
+		if match(item) {
+			found = append(found, item)
+		}
+	}
+
+	var zero T
+	switch len(found) {
+	case 0:
+		return zero, fmt.Errorf("no %s with %s found", what, by)
+	case 1:
+		return found[0], nil
+	}
+
+	ids := make([]string, 0, len(found))
+	for _, item := range found {
+		ids = append(ids, id(item))
+	}
+	return zero, fmt.Errorf("%s %s is ambiguous: it matches %d objects (IDs: %s); look it up by id instead",
+		what, by, len(found), strings.Join(ids, ", "))
+}
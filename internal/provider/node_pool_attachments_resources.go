@@ -82,6 +82,8 @@ type nodePoolServersModel struct {
 	ID         types.String `tfsdk:"id"`
 	NodePoolID types.String `tfsdk:"node_pool_id"`
 	ServerIDs  types.Set    `tfsdk:"server_ids"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewNodePoolServersResource() resource.Resource {
@@ -115,6 +117,7 @@ func (r *nodePoolServersResource) Schema(_ context.Context, _ resource.SchemaReq
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -147,6 +150,11 @@ func (r *nodePoolServersResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := plan.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set.")
@@ -195,6 +203,11 @@ func (r *nodePoolServersResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.State.RemoveResource(ctx)
@@ -235,6 +248,11 @@ func (r *nodePoolServersResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set in state.")
@@ -298,6 +316,11 @@ func (r *nodePoolServersResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		return
@@ -324,8 +347,8 @@ func (r *nodePoolServersResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *nodePoolServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_node_pool_servers.example <node_pool_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("node_pool_id"), req, resp)
+	// terraform import autoglue_node_pool_servers.example <node_pool_id> or <org_id>/<node_pool_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("node_pool_id"), req, resp)
 }
 
 func (r *nodePoolServersResource) readServersIntoModel(
@@ -382,6 +405,8 @@ type nodePoolTaintsModel struct {
 	ID         types.String `tfsdk:"id"`
 	NodePoolID types.String `tfsdk:"node_pool_id"`
 	TaintIDs   types.Set    `tfsdk:"taint_ids"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewNodePoolTaintsResource() resource.Resource {
@@ -415,6 +440,7 @@ func (r *nodePoolTaintsResource) Schema(_ context.Context, _ resource.SchemaRequ
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -447,6 +473,11 @@ func (r *nodePoolTaintsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := plan.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set.")
@@ -495,6 +526,11 @@ func (r *nodePoolTaintsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.State.RemoveResource(ctx)
@@ -535,6 +571,11 @@ func (r *nodePoolTaintsResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set in state.")
@@ -598,6 +639,11 @@ func (r *nodePoolTaintsResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		return
@@ -624,8 +670,8 @@ func (r *nodePoolTaintsResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *nodePoolTaintsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_node_pool_taints.example <node_pool_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("node_pool_id"), req, resp)
+	// terraform import autoglue_node_pool_taints.example <node_pool_id> or <org_id>/<node_pool_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("node_pool_id"), req, resp)
 }
 
 func (r *nodePoolTaintsResource) readTaintsIntoModel(
@@ -682,6 +728,8 @@ type nodePoolLabelsModel struct {
 	ID         types.String `tfsdk:"id"`
 	NodePoolID types.String `tfsdk:"node_pool_id"`
 	LabelIDs   types.Set    `tfsdk:"label_ids"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewNodePoolLabelsResource() resource.Resource {
@@ -715,6 +763,7 @@ func (r *nodePoolLabelsResource) Schema(_ context.Context, _ resource.SchemaRequ
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -747,6 +796,11 @@ func (r *nodePoolLabelsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := plan.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set.")
@@ -795,6 +849,11 @@ func (r *nodePoolLabelsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.State.RemoveResource(ctx)
@@ -835,6 +894,11 @@ func (r *nodePoolLabelsResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set in state.")
@@ -898,6 +962,11 @@ func (r *nodePoolLabelsResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		return
@@ -924,8 +993,8 @@ func (r *nodePoolLabelsResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *nodePoolLabelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_node_pool_labels.example <node_pool_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("node_pool_id"), req, resp)
+	// terraform import autoglue_node_pool_labels.example <node_pool_id> or <org_id>/<node_pool_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("node_pool_id"), req, resp)
 }
 
 func (r *nodePoolLabelsResource) readLabelsIntoModel(
@@ -982,6 +1051,8 @@ type nodePoolAnnotationsModel struct {
 	ID            types.String `tfsdk:"id"`
 	NodePoolID    types.String `tfsdk:"node_pool_id"`
 	AnnotationIDs types.Set    `tfsdk:"annotation_ids"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewNodePoolAnnotationsResource() resource.Resource {
@@ -1015,6 +1086,7 @@ func (r *nodePoolAnnotationsResource) Schema(_ context.Context, _ resource.Schem
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -1047,6 +1119,11 @@ func (r *nodePoolAnnotationsResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := plan.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set.")
@@ -1095,6 +1172,11 @@ func (r *nodePoolAnnotationsResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.State.RemoveResource(ctx)
@@ -1135,6 +1217,11 @@ func (r *nodePoolAnnotationsResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		resp.Diagnostics.AddError("Missing node_pool_id", "node_pool_id must be set in state.")
@@ -1198,6 +1285,11 @@ func (r *nodePoolAnnotationsResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodePoolID := state.NodePoolID.ValueString()
 	if nodePoolID == "" {
 		return
@@ -1224,8 +1316,8 @@ func (r *nodePoolAnnotationsResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *nodePoolAnnotationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_node_pool_annotations.example <node_pool_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("node_pool_id"), req, resp)
+	// terraform import autoglue_node_pool_annotations.example <node_pool_id> or <org_id>/<node_pool_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("node_pool_id"), req, resp)
 }
 
 func (r *nodePoolAnnotationsResource) readAnnotationsIntoModel(
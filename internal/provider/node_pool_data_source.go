@@ -27,6 +27,7 @@ type nodePoolDataSourceModel struct {
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
 	OrganizationID types.String `tfsdk:"organization_id"`
+	OrgID          types.String `tfsdk:"org_id"`
 }
 
 func NewNodePoolDataSource() datasource.DataSource {
@@ -61,6 +62,7 @@ func (d *nodePoolDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 				Description: "Last update timestamp.",
 			},
+			"org_id": orgIDDataSourceAttribute(),
 			"organization_id": dsschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -99,6 +101,11 @@ func (d *nodePoolDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := config.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "id must be set to read a node pool.")
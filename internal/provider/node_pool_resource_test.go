@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func attachedIDs(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for id := range set {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestNodePoolResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	np := h.resource("autoglue_node_pool")
+	np.Apply(map[string]any{"name": "workers", "role": "worker"})
+	id := np.Attr("id")
+
+	np.Apply(map[string]any{"name": "workers-a", "role": "worker"})
+	if np.Attr("id") != id || api.nodePools[id].Name != "workers-a" {
+		t.Errorf("node pool not updated in place: %+v", api.nodePools[id])
+	}
+
+	np.ImportVerify(id)
+
+	ds := h.ReadDataSource("autoglue_node_pool", map[string]any{"id": id})
+	if got := valueString(t, ds["name"]); got != "workers-a" {
+		t.Errorf("node pool data source name = %q, want workers-a", got)
+	}
+
+	np.Destroy()
+	if len(api.nodePools) != 0 {
+		t.Errorf("expected node pool to be deleted")
+	}
+}
+
+func TestNodePoolAttachmentResources(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	np := h.resource("autoglue_node_pool")
+	np.Apply(map[string]any{"name": "workers", "role": "worker"})
+	npID := np.Attr("id")
+
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "nodes"})
+
+	var servers, taints, labels, annotations []string
+	for _, host := range []string{"w1", "w2", "w3"} {
+		s := h.resource("autoglue_server")
+		s.Apply(map[string]any{"hostname": host, "ssh_key_id": key.Attr("id"), "ssh_user": "ubuntu"})
+		servers = append(servers, s.Attr("id"))
+
+		tn := h.resource("autoglue_taint")
+		tn.Apply(map[string]any{"key": "k-" + host, "effect": "NoSchedule"})
+		taints = append(taints, tn.Attr("id"))
+
+		l := h.resource("autoglue_label")
+		l.Apply(map[string]any{"key": "k-" + host, "value": "v"})
+		labels = append(labels, l.Attr("id"))
+
+		a := h.resource("autoglue_annotation")
+		a.Apply(map[string]any{"key": "k-" + host, "value": "v"})
+		annotations = append(annotations, a.Attr("id"))
+	}
+
+	cases := []struct {
+		typeName string
+		field    string
+		ids      []string
+		attached func() map[string]bool
+	}{
+		{"autoglue_node_pool_servers", "server_ids", servers, func() map[string]bool { return api.nodePools[npID].serverIDs }},
+		{"autoglue_node_pool_taints", "taint_ids", taints, func() map[string]bool { return api.nodePools[npID].taintIDs }},
+		{"autoglue_node_pool_labels", "label_ids", labels, func() map[string]bool { return api.nodePools[npID].labelIDs }},
+		{"autoglue_node_pool_annotations", "annotation_ids", annotations, func() map[string]bool { return api.nodePools[npID].annotationIDs }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.typeName, func(t *testing.T) {
+			h := newTestHarness(t, api)
+			att := h.resource(tc.typeName)
+
+			att.Apply(map[string]any{"node_pool_id": npID, tc.field: tc.ids[:2]})
+			if got := attachedIDs(tc.attached()); !reflect.DeepEqual(got, tc.ids[:2]) {
+				t.Fatalf("attached = %v, want %v", got, tc.ids[:2])
+			}
+
+			// Swap one member: detach ids[0], attach ids[2].
+			att.Apply(map[string]any{"node_pool_id": npID, tc.field: tc.ids[1:]})
+			if got := attachedIDs(tc.attached()); !reflect.DeepEqual(got, tc.ids[1:]) {
+				t.Fatalf("attached = %v, want %v", got, tc.ids[1:])
+			}
+
+			att.ImportVerify(npID)
+
+			att.Destroy()
+			if got := tc.attached(); len(got) != 0 {
+				t.Fatalf("expected all detached, got %v", got)
+			}
+		})
+	}
+}
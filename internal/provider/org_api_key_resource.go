@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &orgAPIKeyResource{}
+	_ resource.ResourceWithConfigure   = &orgAPIKeyResource{}
+	_ resource.ResourceWithImportState = &orgAPIKeyResource{}
+)
+
+type orgAPIKeyResource struct {
+	client *autoglueClient
+}
+
+type orgAPIKeyResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrgID          types.String `tfsdk:"org_id"`
+	Name           types.String `tfsdk:"name"`
+	ExpiresInHours types.Int64  `tfsdk:"expires_in_hours"`
+	Scope          types.String `tfsdk:"scope"`
+	Prefix         types.String `tfsdk:"prefix"`
+	OrgKey         types.String `tfsdk:"org_key"`
+	OrgSecret      types.String `tfsdk:"org_secret"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	LastUsedAt     types.String `tfsdk:"last_used_at"`
+}
+
+func NewOrgAPIKeyResource() resource.Resource {
+	return &orgAPIKeyResource{}
+}
+
+func (r *orgAPIKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_api_key"
+}
+
+func (r *orgAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		Description: "Mints an org-scoped API key/secret pair (X-ORG-KEY / X-ORG-SECRET). " +
+			"The key and secret are only returned when the key is created and are stored in Terraform state. " +
+			"Keys cannot be modified; any change creates a new key. A key revoked outside Terraform is recreated.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "API key ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": resourceschema.StringAttribute{
+				Required:    true,
+				Description: "Organization ID the key belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": resourceschema.StringAttribute{
+				Optional:    true,
+				Description: "Human-readable key name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in_hours": resourceschema.Int64Attribute{
+				Optional:    true,
+				Description: "Optional key lifetime in hours. If omitted the key does not expire.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"scope": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Key scope (always `org`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Non-secret key prefix, useful for identifying the key in audit logs.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_key": resourceschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Org key (X-ORG-KEY). Only available on the resource that created it; null after import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_secret": resourceschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Org secret (X-ORG-SECRET). Only available on the resource that created it; null after import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Expiry timestamp (RFC3339), if the key expires.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp the key was last used (RFC3339), if ever.",
+			},
+		},
+	}
+}
+
+func (r *orgAPIKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *orgAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan orgAPIKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := plan.OrgID.ValueString()
+	payload := createOrgAPIKeyPayload{
+		Name:           plan.Name.ValueString(),
+		ExpiresInHours: plan.ExpiresInHours.ValueInt64Pointer(),
+	}
+
+	tflog.Info(ctx, "Creating Autoglue org API key", map[string]any{
+		"org_id": orgID,
+		"name":   payload.Name,
+	})
+
+	path := fmt.Sprintf("/orgs/%s/api-keys", orgID)
+	var created orgAPIKeyCreated
+	if err := r.client.doJSON(ctx, http.MethodPost, path, "", payload, &created); err != nil {
+		resp.Diagnostics.AddError("Error creating org API key", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	plan.Scope = types.StringValue(created.Scope)
+	plan.OrgKey = types.StringValue(created.OrgKey)
+	plan.OrgSecret = types.StringValue(created.OrgSecret)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+	plan.ExpiresAt = optionalTimestamp(created.ExpiresAt)
+	plan.Prefix = types.StringNull()
+	plan.LastUsedAt = types.StringNull()
+
+	// The create response omits list-only fields such as prefix; fill them in.
+	key, err := r.client.findOrgAPIKey(ctx, orgID, created.ID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to read org API key after create", err.Error())
+	} else if key != nil {
+		syncOrgAPIKeyFromAPI(&plan, orgID, key)
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state orgAPIKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	id := state.ID.ValueString()
+	if orgID == "" || id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	key, err := r.client.findOrgAPIKey(ctx, orgID, id)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading org API key", fmt.Sprintf("Error reading org API key %s: %s", id, err.Error()))
+		return
+	}
+	if key == nil || key.Revoked {
+		tflog.Info(ctx, "Org API key no longer active; removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	syncOrgAPIKeyFromAPI(&state, orgID, key)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement; only computed values can change.
+	var plan orgAPIKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state orgAPIKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	id := state.ID.ValueString()
+	if orgID == "" || id == "" {
+		return
+	}
+
+	path := fmt.Sprintf("/orgs/%s/api-keys/%s", orgID, id)
+	tflog.Info(ctx, "Deleting Autoglue org API key", map[string]any{
+		"org_id": orgID,
+		"id":     id,
+	})
+
+	if err := r.client.doJSON(ctx, http.MethodDelete, path, "", nil, nil); err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting org API key", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *orgAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// terraform import autoglue_org_api_key.example <org_id>/<key_id>
+	importStateCompositeID(ctx, "autoglue_org_api_key", req, resp)
+}
+
+// findOrgAPIKey returns the key with keyID, or nil if it does not exist in orgID.
+func (c *autoglueClient) findOrgAPIKey(ctx context.Context, orgID, keyID string) (*orgAPIKey, error) {
+	path := fmt.Sprintf("/orgs/%s/api-keys", orgID)
+
+	var keys []orgAPIKey
+	if err := c.doJSON(ctx, http.MethodGet, path, "", nil, &keys); err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		if keys[i].ID == keyID {
+			return &keys[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// syncOrgAPIKeyFromAPI maps the list representation of a key. The key/secret
+// pair is never part of it, so OrgKey and OrgSecret are left untouched.
+func syncOrgAPIKeyFromAPI(state *orgAPIKeyResourceModel, orgID string, api *orgAPIKey) {
+	state.ID = types.StringValue(api.ID)
+	state.OrgID = types.StringValue(orgID)
+	if api.Name != "" {
+		state.Name = types.StringValue(api.Name)
+	} else {
+		state.Name = types.StringNull()
+	}
+	state.Scope = types.StringValue(api.Scope)
+	state.Prefix = types.StringValue(api.Prefix)
+	state.CreatedAt = types.StringValue(api.CreatedAt)
+	state.ExpiresAt = types.StringPointerValue(api.ExpiresAt)
+	state.LastUsedAt = types.StringPointerValue(api.LastUsedAt)
+}
+
+func optionalTimestamp(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
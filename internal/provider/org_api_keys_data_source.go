@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &orgAPIKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &orgAPIKeysDataSource{}
+)
+
+type orgAPIKeysDataSource struct {
+	client *autoglueClient
+}
+
+type orgAPIKeysDataSourceModel struct {
+	OrgID   types.String         `tfsdk:"org_id"`
+	APIKeys []orgAPIKeyDataModel `tfsdk:"api_keys"`
+}
+
+type orgAPIKeyDataModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Prefix     types.String `tfsdk:"prefix"`
+	Scope      types.String `tfsdk:"scope"`
+	Revoked    types.Bool   `tfsdk:"revoked"`
+	UserID     types.String `tfsdk:"user_id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	LastUsedAt types.String `tfsdk:"last_used_at"`
+}
+
+func NewOrgAPIKeysDataSource() datasource.DataSource {
+	return &orgAPIKeysDataSource{}
+}
+
+func (d *orgAPIKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_api_keys"
+}
+
+func (d *orgAPIKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Lists the API keys of an organization. Key secrets are never returned.",
+		Attributes: map[string]dsschema.Attribute{
+			"org_id": dsschema.StringAttribute{
+				Required:    true,
+				Description: "Organization ID.",
+			},
+			"api_keys": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Organization API keys.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "API key ID.",
+						},
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Key name.",
+						},
+						"prefix": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Non-secret key prefix.",
+						},
+						"scope": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Key scope.",
+						},
+						"revoked": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the key has been revoked.",
+						},
+						"user_id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user that created the key.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Creation timestamp.",
+						},
+						"updated_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Last update timestamp.",
+						},
+						"expires_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Expiry timestamp, if any.",
+						},
+						"last_used_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Last use timestamp, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *orgAPIKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *orgAPIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config orgAPIKeysDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := config.OrgID.ValueString()
+	if orgID == "" {
+		resp.Diagnostics.AddError("Missing org_id", "org_id must be set to list organization API keys.")
+		return
+	}
+
+	tflog.Info(ctx, "Listing Autoglue organization API keys", map[string]any{"org_id": orgID})
+
+	path := fmt.Sprintf("/orgs/%s/api-keys", orgID)
+	apiResp, err := listAll[orgAPIKey](ctx, d.client, path, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing organization API keys", err.Error())
+		return
+	}
+
+	config.APIKeys = make([]orgAPIKeyDataModel, 0, len(apiResp))
+	for _, k := range apiResp {
+		config.APIKeys = append(config.APIKeys, orgAPIKeyDataModel{
+			ID:         types.StringValue(k.ID),
+			Name:       types.StringValue(k.Name),
+			Prefix:     types.StringValue(k.Prefix),
+			Scope:      types.StringValue(k.Scope),
+			Revoked:    types.BoolValue(k.Revoked),
+			UserID:     types.StringValue(k.UserID),
+			CreatedAt:  types.StringValue(k.CreatedAt),
+			UpdatedAt:  types.StringValue(k.UpdatedAt),
+			ExpiresAt:  types.StringPointerValue(k.ExpiresAt),
+			LastUsedAt: types.StringPointerValue(k.LastUsedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &orgMemberResource{}
+	_ resource.ResourceWithConfigure   = &orgMemberResource{}
+	_ resource.ResourceWithImportState = &orgMemberResource{}
+)
+
+type orgMemberResource struct {
+	client *autoglueClient
+}
+
+type orgMemberResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	OrgID  types.String `tfsdk:"org_id"`
+	UserID types.String `tfsdk:"user_id"`
+	Role   types.String `tfsdk:"role"`
+	Email  types.String `tfsdk:"email"`
+}
+
+func NewOrgMemberResource() resource.Resource {
+	return &orgMemberResource{}
+}
+
+func (r *orgMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_member"
+}
+
+func (r *orgMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		Description: "Manages a user's membership (and role) in an Autoglue organization.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Synthetic ID, `<org_id>/<user_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": resourceschema.StringAttribute{
+				Required:    true,
+				Description: "Organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": resourceschema.StringAttribute{
+				Required:    true,
+				Description: "User ID to add to the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": resourceschema.StringAttribute{
+				Required:    true,
+				Description: "Member role: \"owner\", \"admin\" or \"member\".",
+				Validators: []validator.String{
+					stringvalidator.OneOf("owner", "admin", "member"),
+				},
+			},
+			"email": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Primary email of the member.",
+			},
+		},
+	}
+}
+
+func (r *orgMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *orgMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan orgMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := plan.OrgID.ValueString()
+	payload := upsertOrgMemberPayload{
+		UserID: plan.UserID.ValueString(),
+		Role:   plan.Role.ValueString(),
+	}
+
+	tflog.Info(ctx, "Adding Autoglue organization member", map[string]any{
+		"org_id":  orgID,
+		"user_id": payload.UserID,
+		"role":    payload.Role,
+	})
+
+	path := fmt.Sprintf("/orgs/%s/members", orgID)
+	var apiResp orgMember
+	if err := r.client.doJSON(ctx, http.MethodPost, path, "", payload, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Error adding organization member", err.Error())
+		return
+	}
+
+	syncOrgMemberFromAPI(&plan, orgID, &apiResp)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state orgMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	userID := state.UserID.ValueString()
+	if orgID == "" || userID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	member, err := r.client.findOrgMember(ctx, orgID, userID)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading organization member", fmt.Sprintf("Error reading member %s of organization %s: %s", userID, orgID, err.Error()))
+		return
+	}
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	syncOrgMemberFromAPI(&state, orgID, member)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan orgMemberResourceModel
+	var state orgMemberResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Role.Equal(state.Role) {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	payload := upsertOrgMemberPayload{
+		UserID: state.UserID.ValueString(),
+		Role:   plan.Role.ValueString(),
+	}
+
+	tflog.Info(ctx, "Updating Autoglue organization member role", map[string]any{
+		"org_id":  orgID,
+		"user_id": payload.UserID,
+		"role":    payload.Role,
+	})
+
+	path := fmt.Sprintf("/orgs/%s/members", orgID)
+	var apiResp orgMember
+	if err := r.client.doJSON(ctx, http.MethodPost, path, "", payload, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Error updating organization member", err.Error())
+		return
+	}
+
+	syncOrgMemberFromAPI(&plan, orgID, &apiResp)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state orgMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	userID := state.UserID.ValueString()
+	if orgID == "" || userID == "" {
+		return
+	}
+
+	path := fmt.Sprintf("/orgs/%s/members/%s", orgID, userID)
+	tflog.Info(ctx, "Removing Autoglue organization member", map[string]any{
+		"org_id":  orgID,
+		"user_id": userID,
+	})
+
+	if err := r.client.doJSON(ctx, http.MethodDelete, path, "", nil, nil); err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error removing organization member", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *orgMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// terraform import autoglue_org_member.example <org_id>/<user_id>
+	importStateCompositeID(ctx, "autoglue_org_member", req, resp)
+}
+
+// findOrgMember returns the member with userID, or nil if the user is not a member of orgID.
+func (c *autoglueClient) findOrgMember(ctx context.Context, orgID, userID string) (*orgMember, error) {
+	path := fmt.Sprintf("/orgs/%s/members", orgID)
+
+	var members []orgMember
+	if err := c.doJSON(ctx, http.MethodGet, path, "", nil, &members); err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if members[i].UserID == userID {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func syncOrgMemberFromAPI(state *orgMemberResourceModel, orgID string, api *orgMember) {
+	state.ID = types.StringValue(orgID + "/" + api.UserID)
+	state.OrgID = types.StringValue(orgID)
+	state.UserID = types.StringValue(api.UserID)
+	state.Role = types.StringValue(api.Role)
+	state.Email = types.StringValue(api.Email)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &orgMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &orgMembersDataSource{}
+)
+
+type orgMembersDataSource struct {
+	client *autoglueClient
+}
+
+type orgMembersDataSourceModel struct {
+	OrgID   types.String         `tfsdk:"org_id"`
+	Role    types.String         `tfsdk:"role"`
+	Members []orgMemberDataModel `tfsdk:"members"`
+}
+
+type orgMemberDataModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+}
+
+func NewOrgMembersDataSource() datasource.DataSource {
+	return &orgMembersDataSource{}
+}
+
+func (d *orgMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_members"
+}
+
+func (d *orgMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Lists the members of an organization.",
+		Attributes: map[string]dsschema.Attribute{
+			"org_id": dsschema.StringAttribute{
+				Required:    true,
+				Description: "Organization ID.",
+			},
+			"role": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional role filter (`owner`, `admin` or `member`).",
+			},
+			"members": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching organization members.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"user_id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "User ID.",
+						},
+						"email": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Primary email of the member.",
+						},
+						"role": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Member role (owner, admin or member).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *orgMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *orgMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config orgMembersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := config.OrgID.ValueString()
+	if orgID == "" {
+		resp.Diagnostics.AddError("Missing org_id", "org_id must be set to list organization members.")
+		return
+	}
+
+	tflog.Info(ctx, "Listing Autoglue organization members", map[string]any{"org_id": orgID})
+
+	q := url.Values{}
+	setQueryFilter(q, "role", config.Role)
+
+	path := fmt.Sprintf("/orgs/%s/members", orgID)
+	apiResp, err := listAll[orgMember](ctx, d.client, path, q)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing organization members", err.Error())
+		return
+	}
+
+	config.Members = make([]orgMemberDataModel, 0, len(apiResp))
+	for _, m := range apiResp {
+		if !matchesExact(config.Role, m.Role) {
+			continue
+		}
+		config.Members = append(config.Members, orgMemberDataModel{
+			UserID: types.StringValue(m.UserID),
+			Email:  types.StringValue(m.Email),
+			Role:   types.StringValue(m.Role),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
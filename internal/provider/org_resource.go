@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &orgResource{}
+	_ resource.ResourceWithConfigure   = &orgResource{}
+	_ resource.ResourceWithImportState = &orgResource{}
+)
+
+type orgResource struct {
+	client *autoglueClient
+}
+
+type orgResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Domain    types.String `tfsdk:"domain"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func NewOrgResource() resource.Resource {
+	return &orgResource{}
+}
+
+func (r *orgResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org"
+}
+
+func (r *orgResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		Description: "Manages an Autoglue organization. The caller becomes the owner of organizations it creates.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Organization ID (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"name": resourceschema.StringAttribute{
+				Required:    true,
+				Description: "Organization name.",
+			},
+
+			"domain": resourceschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional email domain associated with the organization (e.g. `example.com`).",
+			},
+
+			"created_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+			},
+
+			"updated_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Last update timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func (r *orgResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan orgResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := createOrgPayload{
+		Name:   plan.Name.ValueString(),
+		Domain: stringPointerFromAttr(plan.Domain),
+	}
+
+	tflog.Info(ctx, "Creating Autoglue organization", map[string]any{
+		"name":   payload.Name,
+		"domain": plan.Domain.ValueString(),
+	})
+
+	var apiResp org
+	if err := r.client.doJSON(ctx, http.MethodPost, "/orgs", "", payload, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Error creating organization", err.Error())
+		return
+	}
+
+	syncOrgFromAPI(&plan, &apiResp)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state orgResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	path := fmt.Sprintf("/orgs/%s", id)
+	tflog.Info(ctx, "Reading Autoglue organization", map[string]any{"id": id})
+
+	var apiResp org
+	if err := r.client.doJSON(ctx, http.MethodGet, path, "", nil, &apiResp); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading organization", fmt.Sprintf("Error reading organization %s: %s", id, err.Error()))
+		return
+	}
+
+	syncOrgFromAPI(&state, &apiResp)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan orgResourceModel
+	var state orgResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("Missing ID", "Organization ID is required in state to update organization.")
+		return
+	}
+
+	var payload updateOrgPayload
+	if !plan.Name.Equal(state.Name) {
+		v := plan.Name.ValueString()
+		payload.Name = &v
+	}
+	if !plan.Domain.Equal(state.Domain) {
+		// An empty string clears the domain server-side.
+		v := plan.Domain.ValueString()
+		payload.Domain = &v
+	}
+
+	if payload.Name == nil && payload.Domain == nil {
+		tflog.Info(ctx, "No changes detected for Autoglue organization", map[string]any{"id": id})
+		return
+	}
+
+	path := fmt.Sprintf("/orgs/%s", id)
+	tflog.Info(ctx, "Updating Autoglue organization", map[string]any{
+		"id":     id,
+		"name":   plan.Name.ValueString(),
+		"domain": plan.Domain.ValueString(),
+	})
+
+	var apiResp org
+	if err := r.client.doJSON(ctx, http.MethodPatch, path, "", payload, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Error updating organization", err.Error())
+		return
+	}
+
+	syncOrgFromAPI(&plan, &apiResp)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *orgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state orgResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		return
+	}
+
+	path := fmt.Sprintf("/orgs/%s", id)
+	tflog.Info(ctx, "Deleting Autoglue organization", map[string]any{"id": id})
+
+	if err := r.client.doJSON(ctx, http.MethodDelete, path, "", nil, nil); err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting organization", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *orgResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// terraform import autoglue_org.example <org_id>
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func syncOrgFromAPI(state *orgResourceModel, api *org) {
+	state.ID = types.StringValue(api.ID)
+	state.Name = types.StringValue(api.Name)
+	if api.Domain != nil && *api.Domain != "" {
+		state.Domain = types.StringValue(*api.Domain)
+	} else {
+		state.Domain = types.StringNull()
+	}
+	state.CreatedAt = types.StringValue(api.CreatedAt)
+	state.UpdatedAt = types.StringValue(api.UpdatedAt)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import "testing"
+
+func TestOrgResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	o := h.resource("autoglue_org")
+	o.Apply(map[string]any{"name": "platform", "domain": "example.com"})
+	id := o.Attr("id")
+	if got := api.orgs[id]; got == nil || got.Name != "platform" || got.Domain == nil || *got.Domain != "example.com" {
+		t.Fatalf("org not created: %+v", got)
+	}
+	if role := api.orgs[id].members[fakeCallerID].Role; role != "owner" {
+		t.Errorf("creator role = %q, want owner", role)
+	}
+
+	o.Apply(map[string]any{"name": "platform-eng"})
+	if o.Attr("id") != id || api.orgs[id].Name != "platform-eng" {
+		t.Errorf("org not updated in place: %+v", api.orgs[id])
+	}
+	if api.orgs[id].Domain != nil || !o.Attrs()["domain"].IsNull() {
+		t.Errorf("domain not cleared: api %v, state %s", api.orgs[id].Domain, o.Attrs()["domain"])
+	}
+	o.ExpectNoChanges(map[string]any{"name": "platform-eng"})
+
+	o.ImportVerify(id)
+
+	// Renamed out-of-band.
+	api.orgs[id].Name = "renamed"
+	o.Refresh()
+	if got := o.Attr("name"); got != "renamed" {
+		t.Errorf("name after refresh = %q, want renamed", got)
+	}
+
+	list := h.ReadDataSource("autoglue_orgs", nil)
+	names := map[string]bool{}
+	for _, item := range objectElems(t, list["orgs"]) {
+		names[valueString(t, item["name"])] = true
+	}
+	if !names["renamed"] || !names["Test Org"] || len(names) != 3 {
+		t.Errorf("orgs data source returned %v", names)
+	}
+
+	o.Destroy()
+	if _, ok := api.orgs[id]; ok {
+		t.Errorf("expected org to be deleted")
+	}
+}
+
+func TestOrgMemberResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	const userID = "55555555-5555-5555-5555-555555555555"
+	m := h.resource("autoglue_org_member")
+	m.Apply(map[string]any{"org_id": api.orgID, "user_id": userID, "role": "member"})
+	if m.Attr("id") != api.orgID+"/"+userID || m.Attr("email") != "ops@example.com" {
+		t.Errorf("member state: id=%q email=%q", m.Attr("id"), m.Attr("email"))
+	}
+
+	m.Apply(map[string]any{"org_id": api.orgID, "user_id": userID, "role": "admin"})
+	if got := api.orgs[api.orgID].members[userID].Role; got != "admin" {
+		t.Errorf("role = %q, want admin", got)
+	}
+	m.ExpectReplace(map[string]any{"org_id": otherOrgID, "user_id": userID, "role": "admin"})
+
+	m.ImportVerify(api.orgID + "/" + userID)
+	h.ImportExpectError("autoglue_org_member", userID, "<org_id>/<user_id>")
+
+	admins := h.ReadDataSource("autoglue_org_members", map[string]any{"org_id": api.orgID, "role": "admin"})
+	got := objectElems(t, admins["members"])
+	if len(got) != 1 || valueString(t, got[0]["user_id"]) != userID {
+		t.Errorf("admins = %v, want only %s", got, userID)
+	}
+	all := h.ReadDataSource("autoglue_org_members", map[string]any{"org_id": api.orgID})
+	if n := len(objectElems(t, all["members"])); n != 2 {
+		t.Errorf("members data source returned %d, want 2", n)
+	}
+
+	// Removed out-of-band.
+	delete(api.orgs[api.orgID].members, userID)
+	if !m.Gone() {
+		t.Errorf("removed member still in state")
+	}
+
+	h.resource("autoglue_org_member").ApplyExpectError(
+		map[string]any{"org_id": api.orgID, "user_id": "66666666-6666-6666-6666-666666666666", "role": "member"},
+		"user not found")
+}
+
+func TestOrgAPIKeyResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	key := h.resource("autoglue_org_api_key")
+	key.Apply(map[string]any{"org_id": api.orgID, "name": "ci", "expires_in_hours": 24})
+	id := key.Attr("id")
+	stored := api.orgs[api.orgID].apiKeys[id]
+	if stored == nil {
+		t.Fatalf("org API key not created")
+	}
+	if key.Attr("org_secret") != "secret-"+id || key.Attr("org_key") == "" {
+		t.Errorf("key/secret pair not stored: org_key=%q", key.Attr("org_key"))
+	}
+	if key.Attr("prefix") != stored.Prefix || key.Attr("scope") != "org" {
+		t.Errorf("prefix=%q scope=%q, want %q and org", key.Attr("prefix"), key.Attr("scope"), stored.Prefix)
+	}
+	if key.Attr("expires_at") != *stored.ExpiresAt {
+		t.Errorf("expires_at = %q, want %q", key.Attr("expires_at"), *stored.ExpiresAt)
+	}
+	key.ExpectNoChanges(map[string]any{"org_id": api.orgID, "name": "ci", "expires_in_hours": 24})
+	key.ExpectReplace(map[string]any{"org_id": api.orgID, "name": "deploy", "expires_in_hours": 24})
+
+	// The key/secret pair is only returned on create.
+	key.ImportVerify(api.orgID+"/"+id, "org_key", "org_secret", "expires_in_hours")
+
+	list := h.ReadDataSource("autoglue_org_api_keys", map[string]any{"org_id": api.orgID})
+	keys := objectElems(t, list["api_keys"])
+	if len(keys) != 1 || valueString(t, keys[0]["id"]) != id || valueString(t, keys[0]["revoked"]) != "false" {
+		t.Errorf("org API keys data source returned %v", keys)
+	}
+
+	key.Destroy()
+	if !stored.Revoked {
+		t.Errorf("expected org API key to be revoked")
+	}
+
+	// A key revoked out-of-band drops out of state.
+	other := h.resource("autoglue_org_api_key")
+	other.Apply(map[string]any{"org_id": api.orgID, "name": "other"})
+	api.orgs[api.orgID].apiKeys[other.Attr("id")].Revoked = true
+	if !other.Gone() {
+		t.Errorf("revoked org API key still in state")
+	}
+}
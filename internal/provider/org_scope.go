@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Org-scoped resources and data sources take an optional org_id argument that
+// overrides the provider's org_id for just that object's requests. The
+// override travels in the request context, so helpers and waits called with
+// it are scoped too.
+
+type orgScopeKey struct{}
+
+// withOrgScope returns ctx with requests scoped to orgID (sent as X-Org-ID).
+func withOrgScope(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, orgScopeKey{}, orgID)
+}
+
+// orgScopeFromContext returns the org ID ctx is scoped to, if any.
+func orgScopeFromContext(ctx context.Context) (string, bool) {
+	orgID, ok := ctx.Value(orgScopeKey{}).(string)
+	return orgID, ok && orgID != ""
+}
+
+// meOrganization is an entry of dto.MeResponse.organizations.
+type meOrganization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// orgMemberships caches the caller's organizations, fetched once from GET /me.
+type orgMemberships struct {
+	mu     sync.Mutex
+	loaded bool
+	orgs   map[string]string // ID to name
+}
+
+func (c *autoglueClient) memberOrgs(ctx context.Context) (map[string]string, error) {
+	c.memberships.mu.Lock()
+	defer c.memberships.mu.Unlock()
+	if c.memberships.loaded {
+		return c.memberships.orgs, nil
+	}
+
+	var me struct {
+		Organizations []meOrganization `json:"organizations"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/me", "", nil, &me); err != nil {
+		return nil, err
+	}
+	orgs := make(map[string]string, len(me.Organizations))
+	for _, o := range me.Organizations {
+		orgs[o.ID] = o.Name
+	}
+	c.memberships.orgs, c.memberships.loaded = orgs, true
+	return orgs, nil
+}
+
+// scopeToOrg resolves the org_id argument of a resource or data source. A
+// null or empty value means the provider's org_id; any other org must be one
+// of the caller's memberships. orgID is set to the org actually used, for
+// recording in state, and the returned ctx is scoped to it.
+func (c *autoglueClient) scopeToOrg(ctx context.Context, orgID *types.String, diags *diag.Diagnostics) context.Context {
+	override := orgID.ValueString()
+	if override == "" || override == c.orgID {
+		*orgID = types.StringValue(c.orgID)
+		return ctx
+	}
+
+	if !c.sendOrgHeader {
+		diags.AddAttributeError(path.Root("org_id"), "org_id override not supported",
+			"org_id can only be overridden when authenticating with api_key or a bearer token; "+
+				"org_key and org_secret are bound to a single organization.")
+		return ctx
+	}
+
+	orgs, err := c.memberOrgs(ctx)
+	if err != nil {
+		diags.AddAttributeError(path.Root("org_id"), "Unable to verify org_id",
+			fmt.Sprintf("Listing your organizations to validate org_id %q failed: %s", override, err))
+		return ctx
+	}
+	if _, ok := orgs[override]; !ok {
+		available := make([]string, 0, len(orgs))
+		for id, name := range orgs {
+			available = append(available, fmt.Sprintf("%s (%s)", id, name))
+		}
+		sort.Strings(available)
+		diags.AddAttributeError(path.Root("org_id"), "Unknown organization",
+			fmt.Sprintf("You are not a member of organization %q. Your organizations: %s.",
+				override, strings.Join(available, ", ")))
+		return ctx
+	}
+
+	return withOrgScope(ctx, override)
+}
+
+const orgIDArgumentDescription = "Organization UUID to scope this object's requests to, overriding the provider's org_id. " +
+	"Must be one of your organizations. Requires api_key or bearer token auth."
+
+// orgIDResourceAttribute is the org_id argument of org-scoped resources. The
+// org actually used is recorded in state, and changing it replaces the object.
+func orgIDResourceAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: orgIDArgumentDescription + " Defaults to the provider's org_id at creation; changing it forces replacement.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
+	}
+}
+
+// orgIDDataSourceAttribute is the org_id argument of org-scoped data sources.
+func orgIDDataSourceAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: orgIDArgumentDescription + " Defaults to the provider's org_id.",
+	}
+}
+
+// orgIDEphemeralAttribute is the org_id argument of org-scoped ephemeral resources.
+func orgIDEphemeralAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: orgIDArgumentDescription + " Defaults to the provider's org_id.",
+	}
+}
+
+// splitImportOrg strips an optional "<org_id>/" prefix from an import ID
+// whose unprefixed form has parts "/"-separated parts.
+func splitImportOrg(id string, parts int) (orgID, rest string) {
+	if strings.Count(id, "/") == parts {
+		orgID, rest, _ = strings.Cut(id, "/")
+		return orgID, rest
+	}
+	return "", id
+}
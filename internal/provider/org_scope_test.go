@@ -0,0 +1,73 @@
+package provider
+
+import "testing"
+
+const otherOrgID = "22222222-2222-2222-2222-222222222222"
+
+func TestOrgIDOverride(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	label := h.resource("autoglue_label")
+	cfg := map[string]any{"key": "tier", "value": "web", "org_id": otherOrgID}
+	label.Apply(cfg)
+	id := label.Attr("id")
+	if got := api.labels[id].OrganizationID; got != otherOrgID {
+		t.Fatalf("label created in org %q, want %q", got, otherOrgID)
+	}
+	if label.Attr("organization_id") != otherOrgID {
+		t.Errorf("organization_id = %q, want %q", label.Attr("organization_id"), otherOrgID)
+	}
+
+	label.Refresh()
+	label.ExpectNoChanges(cfg)
+
+	// Objects in another org are invisible with the provider's org_id...
+	if list := h.ReadDataSource("autoglue_labels", nil); len(objectElems(t, list["labels"])) != 0 {
+		t.Errorf("label from %s listed in the provider's org", otherOrgID)
+	}
+	list := h.ReadDataSource("autoglue_labels", map[string]any{"org_id": otherOrgID})
+	if got := len(objectElems(t, list["labels"])); got != 1 {
+		t.Errorf("labels data source with org_id returned %d, want 1", got)
+	}
+
+	// ...so importing them takes an org prefix.
+	imported := label.ImportVerify(otherOrgID + "/" + id)
+	if imported.Attr("org_id") != otherOrgID {
+		t.Errorf("imported org_id = %q, want %q", imported.Attr("org_id"), otherOrgID)
+	}
+
+	// Without an override, the provider's org_id is recorded.
+	own := h.resource("autoglue_label")
+	own.Apply(map[string]any{"key": "tier", "value": "db"})
+	if own.Attr("org_id") != api.orgID {
+		t.Errorf("default org_id = %q, want %q", own.Attr("org_id"), api.orgID)
+	}
+	own.ExpectReplace(map[string]any{"key": "tier", "value": "db", "org_id": otherOrgID})
+
+	label.Destroy()
+	if len(api.labels) != 1 {
+		t.Errorf("expected only the label in %s to be deleted", otherOrgID)
+	}
+}
+
+func TestOrgIDOverride_Invalid(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	h.resource("autoglue_label").ApplyExpectError(
+		map[string]any{"key": "tier", "value": "web", "org_id": "44444444-4444-4444-4444-444444444444"},
+		"You are not a member of organization",
+	)
+	h.ReadDataSourceExpectError("autoglue_server", map[string]any{
+		"id":     "missing",
+		"org_id": "44444444-4444-4444-4444-444444444444",
+	}, otherOrgID+" (Other Org)")
+
+	h = newTestHarnessWithConfig(t, map[string]any{
+		"base_url":   api.URL(),
+		"org_key":    "key",
+		"org_secret": "secret",
+	})
+	h.ReadDataSourceExpectError("autoglue_labels", map[string]any{"org_id": otherOrgID}, "org_id override not supported")
+}
@@ -0,0 +1,69 @@
+package provider
+
+// createOrgPayload matches dto.OrgCreateRequest.
+type createOrgPayload struct {
+	Name   string  `json:"name"`
+	Domain *string `json:"domain,omitempty"`
+}
+
+// updateOrgPayload matches dto.OrgUpdateRequest.
+type updateOrgPayload struct {
+	Name   *string `json:"name,omitempty"`
+	Domain *string `json:"domain,omitempty"`
+}
+
+// org represents models.Organization.
+type org struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Domain    *string `json:"domain"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// upsertOrgMemberPayload matches dto.MemberUpsertRequest. POSTing an existing
+// user_id updates that member's role.
+type upsertOrgMemberPayload struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+// orgMember represents dto.MemberOut.
+type orgMember struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Role   string `json:"role"` // "owner", "admin" or "member"
+}
+
+// createOrgAPIKeyPayload matches dto.OrgKeyCreateRequest.
+type createOrgAPIKeyPayload struct {
+	Name           string `json:"name,omitempty"`
+	ExpiresInHours *int64 `json:"expires_in_hours,omitempty"`
+}
+
+// orgAPIKeyCreated represents dto.OrgKeyCreateResponse. The key/secret pair
+// is only ever returned by this call.
+type orgAPIKeyCreated struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Scope     string `json:"scope"`
+	OrgKey    string `json:"org_key"`
+	OrgSecret string `json:"org_secret"`
+	CreatedAt string `json:"created_at"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// orgAPIKey represents models.APIKey as returned by GET /orgs/{id}/api-keys.
+type orgAPIKey struct {
+	ID         string  `json:"id"`
+	OrgID      string  `json:"org_id"`
+	UserID     string  `json:"user_id"`
+	Name       string  `json:"name"`
+	Prefix     string  `json:"prefix"`
+	Scope      string  `json:"scope"`
+	Revoked    bool    `json:"revoked"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+	ExpiresAt  *string `json:"expires_at"`
+	LastUsedAt *string `json:"last_used_at"`
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &orgsDataSource{}
+	_ datasource.DataSourceWithConfigure = &orgsDataSource{}
+)
+
+type orgsDataSource struct {
+	client *autoglueClient
+}
+
+type orgsDataSourceModel struct {
+	Orgs []orgDataModel `tfsdk:"orgs"`
+}
+
+type orgDataModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Domain    types.String `tfsdk:"domain"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func NewOrgsDataSource() datasource.DataSource {
+	return &orgsDataSource{}
+}
+
+func (d *orgsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orgs"
+}
+
+func (d *orgsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Lists the organizations the caller is a member of.",
+		Attributes: map[string]dsschema.Attribute{
+			"orgs": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Organizations visible to the caller.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Organization ID.",
+						},
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Organization name.",
+						},
+						"domain": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Email domain associated with the organization, if any.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Creation timestamp.",
+						},
+						"updated_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Last update timestamp.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *orgsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *orgsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state orgsDataSourceModel
+
+	tflog.Info(ctx, "Listing Autoglue organizations")
+
+	apiResp, err := listAll[org](ctx, d.client, "/orgs", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing organizations", err.Error())
+		return
+	}
+
+	state.Orgs = make([]orgDataModel, 0, len(apiResp))
+	for _, o := range apiResp {
+		state.Orgs = append(state.Orgs, orgDataModel{
+			ID:        types.StringValue(o.ID),
+			Name:      types.StringValue(o.Name),
+			Domain:    types.StringPointerValue(o.Domain),
+			CreatedAt: types.StringValue(o.CreatedAt),
+			UpdatedAt: types.StringValue(o.UpdatedAt),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseImportIDFunction{}
+
+type parseImportIDFunction struct{}
+
+func NewParseImportIDFunction() function.Function {
+	return &parseImportIDFunction{}
+}
+
+func (f *parseImportIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *parseImportIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	var formats []string
+	for resourceType, c := range compositeImportIDs {
+		formats = append(formats, "`"+resourceType+"` (`"+importIDFormat(c)+"`)")
+	}
+	sort.Strings(formats)
+
+	resp.Definition = function.Definition{
+		Summary: "Splits a composite import ID into the attributes it identifies.",
+		Description: "Parses the import ID of a resource that is imported by a composite ID and returns a map " +
+			"from attribute name to value, e.g. `{org_id = \"...\", user_id = \"...\"}` for `autoglue_org_member`. " +
+			"Supported resource types: " + strings.Join(formats, ", ") + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "Resource type the ID belongs to, e.g. `autoglue_org_member`.",
+			},
+			function.StringParameter{
+				Name:        "import_id",
+				Description: "Composite import ID to parse.",
+			},
+		},
+		Return: function.MapReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *parseImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, importID string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &resourceType, &importID))
+	if resp.Error != nil {
+		return
+	}
+
+	attrs, err := parseImportID(resourceType, importID)
+	if err != nil {
+		// Point at whichever argument is wrong.
+		var argument int64 = 1
+		if _, ok := compositeImportIDs[resourceType]; !ok {
+			argument = 0
+		}
+		resp.Error = function.NewArgumentFuncError(argument, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, attrs))
+}
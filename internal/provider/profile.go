@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// profileSettings are the provider settings a named profile can supply.
+type profileSettings struct {
+	Name   string
+	Source string
+
+	BaseURL     string
+	OrgID       string
+	APIKey      string
+	OrgKey      string
+	OrgSecret   string
+	BearerToken string
+}
+
+// hasCredentials reports whether the profile sets any auth material.
+func (p profileSettings) hasCredentials() bool {
+	return p.APIKey != "" || p.OrgKey != "" || p.OrgSecret != "" || p.BearerToken != ""
+}
+
+// defaultConfigFilePath returns $XDG_CONFIG_HOME/autoglue/config.yaml,
+// falling back to ~/.config/autoglue/config.yaml.
+func defaultConfigFilePath() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "autoglue", "config.yaml")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "autoglue", "config.yaml")
+}
+
+// loadProfile reads the profile called name from the config file at path
+// (the default location if empty). Without a name, the file's
+// current_profile is used, then a profile called "default". A missing file
+// is only an error when a profile or path was asked for explicitly.
+//
+// Profiles may be a mapping of name to settings or a list of entries with a
+// name field, and setting keys may use snake_case or kebab-case, so files
+// written by the Autoglue CLI in either style can be shared.
+func loadProfile(path, name string) (profileSettings, error) {
+	explicitPath := path != ""
+	if !explicitPath {
+		path = defaultConfigFilePath()
+	}
+	if path == "" {
+		if name != "" {
+			return profileSettings{}, fmt.Errorf("profile %q requested but no config file location could be determined; set AUTOGLUE_CONFIG_FILE", name)
+		}
+		return profileSettings{}, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicitPath && name == "" {
+		return profileSettings{}, nil
+	}
+	if err != nil {
+		return profileSettings{}, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var root map[string]any
+	if err := yaml.Unmarshal(raw, &root); err != nil {
+		return profileSettings{}, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	root = normalizeProfileKeys(root)
+	profiles, err := configProfiles(root["profiles"])
+	if err != nil {
+		return profileSettings{}, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	if name == "" {
+		if current, _ := root["current_profile"].(string); current != "" {
+			name = current
+		} else if _, ok := profiles["default"]; ok {
+			name = "default"
+		} else {
+			return profileSettings{}, nil
+		}
+	}
+
+	p, ok := profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return profileSettings{}, fmt.Errorf("profile %q not found in %s (available: %s)", name, path, strings.Join(names, ", "))
+	}
+
+	str := func(key string) string {
+		s, _ := p[key].(string)
+		return s
+	}
+	return profileSettings{
+		Name:        name,
+		Source:      path,
+		BaseURL:     str("base_url"),
+		OrgID:       str("org_id"),
+		APIKey:      str("api_key"),
+		OrgKey:      str("org_key"),
+		OrgSecret:   str("org_secret"),
+		BearerToken: str("bearer_token"),
+	}, nil
+}
+
+// configProfiles indexes the profiles node of a config file by name.
+func configProfiles(node any) (map[string]map[string]any, error) {
+	out := map[string]map[string]any{}
+	switch v := node.(type) {
+	case nil:
+	case map[string]any:
+		for name, settings := range v {
+			m, ok := settings.(map[string]any)
+			if !ok && settings != nil {
+				return nil, fmt.Errorf("profile %q must be a mapping", name)
+			}
+			out[name] = normalizeProfileKeys(m)
+		}
+	case []any:
+		for i, item := range v {
+			m, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("profiles[%d] must be a mapping", i)
+			}
+			m = normalizeProfileKeys(m)
+			name, _ := m["name"].(string)
+			if name == "" {
+				return nil, fmt.Errorf("profiles[%d] has no name", i)
+			}
+			out[name] = m
+		}
+	default:
+		return nil, fmt.Errorf("profiles must be a mapping or a list")
+	}
+	return out, nil
+}
+
+// normalizeProfileKeys lowercases keys and turns kebab-case into snake_case.
+func normalizeProfileKeys(m map[string]any) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[strings.ReplaceAll(strings.ToLower(k), "-", "_")] = v
+	}
+	return out
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
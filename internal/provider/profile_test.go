@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AUTOGLUE_CONFIG_FILE", file)
+	return file
+}
+
+func TestLoadProfile(t *testing.T) {
+	file := writeConfigFile(t, `
+current-profile: staging
+profiles:
+  - name: staging
+    base-url: https://autoglue.staging.internal/api/v1
+    org-id: org-staging
+    api-key: "staging key"
+  - name: prod
+    base_url: https://autoglue.prod.internal/api/v1
+    org_key: ok
+    org_secret: os
+`)
+
+	p, err := loadProfile(file, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "staging" || p.BaseURL != "https://autoglue.staging.internal/api/v1" || p.OrgID != "org-staging" || p.APIKey != "staging key" {
+		t.Errorf("current profile = %+v", p)
+	}
+
+	p, err = loadProfile(file, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.OrgKey != "ok" || p.OrgSecret != "os" || p.APIKey != "" {
+		t.Errorf("prod profile = %+v", p)
+	}
+
+	if _, err := loadProfile(file, "dev"); err == nil || !strings.Contains(err.Error(), "available: prod, staging") {
+		t.Errorf("expected a missing profile error, got %v", err)
+	}
+
+	mapping := writeConfigFile(t, "profiles:\n  default:\n    org_id: org-default\n")
+	if p, err := loadProfile(mapping, ""); err != nil || p.Name != "default" || p.OrgID != "org-default" {
+		t.Errorf("default profile = %+v, %v", p, err)
+	}
+
+	// Anchors, merge keys, flow mappings and block scalars are plain YAML.
+	anchored := writeConfigFile(t, `
+defaults: &defaults
+  base_url: https://autoglue.example.com/api/v1
+profiles:
+  ci:
+    <<: *defaults
+    api_key: >-
+      ci-key
+  adhoc: {org_id: org-adhoc, bearer_token: tok}
+`)
+	if p, err := loadProfile(anchored, "ci"); err != nil || p.BaseURL != "https://autoglue.example.com/api/v1" || p.APIKey != "ci-key" {
+		t.Errorf("anchored profile = %+v, %v", p, err)
+	}
+	if p, err := loadProfile(anchored, "adhoc"); err != nil || p.OrgID != "org-adhoc" || p.BearerToken != "tok" {
+		t.Errorf("flow profile = %+v, %v", p, err)
+	}
+	invalid := writeConfigFile(t, "profiles: [unterminated\n")
+	if _, err := loadProfile(invalid, ""); err == nil || !strings.Contains(err.Error(), "parsing config file") {
+		t.Errorf("expected a parse error, got %v", err)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing.yaml")
+	if _, err := loadProfile(missing, ""); err == nil {
+		t.Errorf("expected an error for an explicit config file that doesn't exist")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	if p, err := loadProfile("", ""); err != nil || p.Name != "" {
+		t.Errorf("expected no profile without a config file, got %+v, %v", p, err)
+	}
+	if _, err := loadProfile("", "prod"); err == nil {
+		t.Errorf("expected an error when a profile is requested without a config file")
+	}
+}
+
+func TestProvider_Profile(t *testing.T) {
+	api := newFakeAPI(t)
+	writeConfigFile(t, `
+profiles:
+  test:
+    base_url: `+api.URL()+`
+    org_id: wrong-org
+    api_key: `+api.apiKey+`
+`)
+
+	// The profile alone supplies a wrong org_id.
+	h := newTestHarnessWithConfig(t, map[string]any{"profile": "test"})
+	h.ReadDataSourceExpectError("autoglue_ssh_keys", map[string]any{}, "unknown organization")
+
+	// AUTOGLUE_ORG_ID beats the profile.
+	t.Setenv("AUTOGLUE_ORG_ID", api.orgID)
+	h = newTestHarnessWithConfig(t, map[string]any{"profile": "test"})
+	h.ReadDataSource("autoglue_ssh_keys", map[string]any{})
+
+	// A provider argument beats both.
+	h = newTestHarnessWithConfig(t, map[string]any{"profile": "test", "org_id": "wrong-org"})
+	h.ReadDataSourceExpectError("autoglue_ssh_keys", map[string]any{}, "unknown organization")
+
+	t.Setenv("AUTOGLUE_PROFILE", "missing")
+	h = newUnconfiguredHarness(t)
+	h.ConfigureExpectError(map[string]any{}, `profile "missing" not found`)
+}
+
+func TestProvider_ProfileCredentialsSingleSource(t *testing.T) {
+	api := newFakeAPI(t)
+	writeConfigFile(t, `
+profiles:
+  test:
+    base_url: `+api.URL()+`
+    org_id: `+api.orgID+`
+    api_key: `+api.apiKey+`
+    org_secret: os
+`)
+
+	// Half of an org key pair from the environment isn't completed from
+	// the profile.
+	t.Setenv("AUTOGLUE_ORG_KEY", "ok")
+	h := newUnconfiguredHarness(t)
+	h.ConfigureExpectError(map[string]any{"profile": "test"}, "both org_key and org_secret must be configured together")
+
+	// Org credentials from provider arguments aren't sent alongside the
+	// profile's api_key, which the fake API would accept.
+	t.Setenv("AUTOGLUE_ORG_KEY", "")
+	h = newTestHarnessWithConfig(t, map[string]any{"profile": "test", "org_key": "ok", "org_secret": "os"})
+	h.ReadDataSourceExpectError("autoglue_ssh_keys", map[string]any{}, "invalid api key")
+}
@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,8 +19,9 @@ import (
 )
 
 var (
-	_ provider.Provider              = &autoglueProvider{}
-	_ provider.ProviderWithFunctions = &autoglueProvider{}
+	_ provider.Provider                       = &autoglueProvider{}
+	_ provider.ProviderWithFunctions          = &autoglueProvider{}
+	_ provider.ProviderWithEphemeralResources = &autoglueProvider{}
 )
 
 type autoglueProvider struct {
@@ -29,6 +35,30 @@ type autoglueProviderModel struct {
 	OrgKey      types.String `tfsdk:"org_key"`
 	OrgSecret   types.String `tfsdk:"org_secret"`
 	BearerToken types.String `tfsdk:"bearer_token"`
+	Profile     types.String `tfsdk:"profile"`
+
+	OIDCToken         types.String `tfsdk:"oidc_token"`
+	OIDCTokenFile     types.String `tfsdk:"oidc_token_file"`
+	OIDCGitHubActions types.Bool   `tfsdk:"oidc_github_actions"`
+	OIDCAudience      types.String `tfsdk:"oidc_audience"`
+	OIDCExchangeURL   types.String `tfsdk:"oidc_token_exchange_url"`
+
+	RetryMaxAttempts types.Int64  `tfsdk:"retry_max_attempts"`
+	RetryMaxWait     types.String `tfsdk:"retry_max_wait"`
+
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	ReadCacheTTL          types.String `tfsdk:"read_cache_ttl"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	Headers            types.Map    `tfsdk:"headers"`
+
+	Preflight types.Bool `tfsdk:"preflight"`
 }
 
 func New(version string) func() provider.Provider {
@@ -48,6 +78,12 @@ func (p *autoglueProvider) Schema(
 	resp *provider.SchemaResponse,
 ) {
 	resp.Schema = providerschema.Schema{
+		Description: "Interact with the Autoglue API.\n\n" +
+			"Each of `base_url` and `org_id` is taken from, in order: the provider argument, its `AUTOGLUE_*` " +
+			"environment variable, then the selected profile of the config file (`AUTOGLUE_CONFIG_FILE`, default " +
+			"`~/.config/autoglue/config.yaml`). Credentials (`api_key`, `org_key` and `org_secret`, `bearer_token` or " +
+			"OIDC) come from a single source: if a provider argument or environment variable sets any of them, the " +
+			"profile's credentials are ignored.",
 		Attributes: map[string]providerschema.Attribute{
 			"base_url": providerschema.StringAttribute{
 				Optional:    true,
@@ -77,6 +113,116 @@ func (p *autoglueProvider) Schema(
 				Sensitive:   true,
 				Description: "Bearer token for Authorization header.",
 			},
+			"profile": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Named profile to read base_url, org_id and auth settings from, in the config file at " +
+					"AUTOGLUE_CONFIG_FILE (default: `~/.config/autoglue/config.yaml`). Provider arguments and " +
+					"AUTOGLUE_* environment variables take precedence over the profile, and any credential set " +
+					"that way replaces all of the profile's credentials. If unset, the file's " +
+					"`current_profile` is used, then a profile named `default`. Can also be set with AUTOGLUE_PROFILE.",
+			},
+			"oidc_token": providerschema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Workload identity (OIDC) token to exchange for short-lived Autoglue bearer tokens, " +
+					"which are refreshed automatically before they expire. Requires org_id. Conflicts with bearer_token. " +
+					"Can also be set with AUTOGLUE_OIDC_TOKEN.",
+			},
+			"oidc_token_file": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Path to a file containing the workload identity token to exchange, e.g. a Kubernetes " +
+					"projected service account token. The file is re-read on every exchange, so rotated tokens are " +
+					"picked up. Can also be set with AUTOGLUE_OIDC_TOKEN_FILE.",
+			},
+			"oidc_github_actions": providerschema.BoolAttribute{
+				Optional: true,
+				Description: "Request the workload identity token from GitHub Actions. The job needs " +
+					"`permissions: id-token: write`. Can also be set with AUTOGLUE_OIDC_GITHUB_ACTIONS.",
+			},
+			"oidc_audience": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Audience requested for, and sent with, the workload identity token. " +
+					"Can also be set with AUTOGLUE_OIDC_AUDIENCE (default: autoglue).",
+			},
+			"oidc_token_exchange_url": providerschema.StringAttribute{
+				Optional: true,
+				Description: "URL of an RFC 8693 token exchange endpoint that issues Autoglue bearer tokens for the " +
+					"workload identity token. The published Autoglue API has no such endpoint, so this is required " +
+					"with oidc_token, oidc_token_file or oidc_github_actions. Can also be set with " +
+					"AUTOGLUE_OIDC_TOKEN_EXCHANGE_URL.",
+			},
+			"retry_max_attempts": providerschema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of attempts (including the first) for requests that are throttled (429) " +
+					"or fail transiently (502/503/504, network errors). Set to 1 to disable retries. " +
+					"Can also be set with AUTOGLUE_RETRY_MAX_ATTEMPTS (default: 4).",
+			},
+			"retry_max_wait": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Maximum time to wait between retries, as a Go duration (e.g. `30s`, `2m`). " +
+					"If the API asks for a longer wait via Retry-After or X-RateLimit-Reset the request fails instead. " +
+					"Can also be set with AUTOGLUE_RETRY_MAX_WAIT (default: 30s).",
+			},
+			"ca_cert_pem": providerschema.StringAttribute{
+				Optional: true,
+				Description: "PEM-encoded CA certificates to trust in addition to the system pool, " +
+					"e.g. for a self-hosted Autoglue behind an internal CA. Can also be set with AUTOGLUE_CA_CERT_PEM.",
+			},
+			"ca_cert_file": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Path to a PEM file of CA certificates to trust in addition to the system pool. " +
+					"Can be combined with ca_cert_pem. Can also be set with AUTOGLUE_CA_CERT_FILE.",
+			},
+			"client_cert": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Client certificate for mTLS, as PEM data or a path to a PEM file. " +
+					"Use together with client_key. Can also be set with AUTOGLUE_CLIENT_CERT.",
+			},
+			"client_key": providerschema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Private key for client_cert, as PEM data or a path to a PEM file. " +
+					"Can also be set with AUTOGLUE_CLIENT_KEY.",
+			},
+			"insecure_skip_verify": providerschema.BoolAttribute{
+				Optional: true,
+				Description: "Skip TLS certificate verification. Insecure; only for testing. " +
+					"Can also be set with AUTOGLUE_INSECURE_SKIP_VERIFY.",
+			},
+			"proxy_url": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Proxy to send API requests through (e.g. `http://proxy.internal:3128`). " +
+					"If unset, HTTPS_PROXY/HTTP_PROXY/NO_PROXY are honored. Can also be set with AUTOGLUE_PROXY_URL.",
+			},
+			"request_timeout": providerschema.StringAttribute{
+				Optional: true,
+				Description: "Timeout for a single API request attempt, as a Go duration (e.g. `30s`, `2m`). " +
+					"Can also be set with AUTOGLUE_REQUEST_TIMEOUT (default: 60s).",
+			},
+			"headers": providerschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Static headers to send with every API request. Authentication, org and X-Correlation-ID " +
+					"headers always take precedence. Values are masked in logs. Can also be set with " +
+					"AUTOGLUE_HEADERS as comma-separated `Name=value` pairs.",
+			},
+			"max_concurrent_requests": providerschema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of API requests in flight at once across all resources. " +
+					"Can also be set with AUTOGLUE_MAX_CONCURRENT_REQUESTS (default: 8).",
+			},
+			"read_cache_ttl": providerschema.StringAttribute{
+				Optional: true,
+				Description: "How long identical GET responses are reused within a run, as a Go duration (e.g. `5s`). " +
+					"Any write clears the cache, and identical concurrent GETs are always coalesced into one request. " +
+					"Set to `0s` to disable. Can also be set with AUTOGLUE_READ_CACHE_TTL (default: 5s).",
+			},
+			"preflight": providerschema.BoolAttribute{
+				Optional: true,
+				Description: "Check connectivity, credentials and org_id membership when the provider is configured, " +
+					"failing with a single diagnostic naming the misconfigured argument instead of on every resource. " +
+					"Also records the server version. Can also be set with AUTOGLUE_PREFLIGHT.",
+			},
 		},
 	}
 }
@@ -92,32 +238,141 @@ func (p *autoglueProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	baseURL := stringOrEnv(config.BaseURL, "AUTOGLUE_BASE_URL")
-	orgID := stringOrEnv(config.OrgID, "AUTOGLUE_ORG_ID")
+	// Precedence: provider argument, then environment, then profile.
+	profile, err := loadProfile(os.Getenv("AUTOGLUE_CONFIG_FILE"), stringOrEnv(config.Profile, "AUTOGLUE_PROFILE"))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile"), "Unable to load Autoglue profile", err.Error())
+		return
+	}
+	if profile.Name != "" {
+		tflog.Info(ctx, "Using Autoglue profile", map[string]any{"profile": profile.Name, "config_file": profile.Source})
+	}
+
+	baseURL := firstNonEmpty(stringOrEnv(config.BaseURL, "AUTOGLUE_BASE_URL"), profile.BaseURL)
+	orgID := firstNonEmpty(stringOrEnv(config.OrgID, "AUTOGLUE_ORG_ID"), profile.OrgID)
 	apiKey := stringOrEnv(config.APIKey, "AUTOGLUE_API_KEY")
 	orgKey := stringOrEnv(config.OrgKey, "AUTOGLUE_ORG_KEY")
 	orgSecret := stringOrEnv(config.OrgSecret, "AUTOGLUE_ORG_SECRET")
 	bearerToken := stringOrEnv(config.BearerToken, "AUTOGLUE_BEARER_TOKEN")
 
+	retryMaxAttempts, err := int64OrEnv(config.RetryMaxAttempts, "AUTOGLUE_RETRY_MAX_ATTEMPTS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_max_attempts"), "Invalid retry_max_attempts", err.Error())
+	}
+	retryMaxWait, err := durationOrEnv(config.RetryMaxWait, "AUTOGLUE_RETRY_MAX_WAIT")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "Invalid retry_max_wait", err.Error())
+	}
+	insecureSkipVerify, err := boolOrEnv(config.InsecureSkipVerify, "AUTOGLUE_INSECURE_SKIP_VERIFY")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid insecure_skip_verify", err.Error())
+	}
+	oidcGitHubActions, err := boolOrEnv(config.OIDCGitHubActions, "AUTOGLUE_OIDC_GITHUB_ACTIONS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("oidc_github_actions"), "Invalid oidc_github_actions", err.Error())
+	}
+	oidc := oidcConfig{
+		ExchangeURL:   stringOrEnv(config.OIDCExchangeURL, "AUTOGLUE_OIDC_TOKEN_EXCHANGE_URL"),
+		Token:         stringOrEnv(config.OIDCToken, "AUTOGLUE_OIDC_TOKEN"),
+		TokenFile:     stringOrEnv(config.OIDCTokenFile, "AUTOGLUE_OIDC_TOKEN_FILE"),
+		GitHubActions: oidcGitHubActions,
+		Audience:      stringOrEnv(config.OIDCAudience, "AUTOGLUE_OIDC_AUDIENCE"),
+	}
+	// Credentials come from one source so auth methods never mix: the
+	// profile's are used only if no argument or environment variable sets any.
+	if apiKey == "" && orgKey == "" && orgSecret == "" && bearerToken == "" && !oidc.enabled() {
+		apiKey, orgKey, orgSecret, bearerToken = profile.APIKey, profile.OrgKey, profile.OrgSecret, profile.BearerToken
+	} else if profile.hasCredentials() {
+		tflog.Info(ctx, "Ignoring Autoglue profile credentials set by provider arguments or environment", map[string]any{"profile": profile.Name})
+	}
+	requestTimeout, err := durationOrEnv(config.RequestTimeout, "AUTOGLUE_REQUEST_TIMEOUT")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", err.Error())
+	}
+	headers, err := stringMapOrEnv(ctx, config.Headers, "AUTOGLUE_HEADERS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("headers"), "Invalid headers", err.Error())
+	}
+	maxConcurrentRequests, err := int64OrEnv(config.MaxConcurrentRequests, "AUTOGLUE_MAX_CONCURRENT_REQUESTS")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid max_concurrent_requests", err.Error())
+	}
+	readCacheTTL, err := durationOrEnv(config.ReadCacheTTL, "AUTOGLUE_READ_CACHE_TTL")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("read_cache_ttl"), "Invalid read_cache_ttl", err.Error())
+	}
+	// Unlike the other durations, an explicit zero is meaningful: it
+	// disables the cache.
+	if stringOrEnv(config.ReadCacheTTL, "AUTOGLUE_READ_CACHE_TTL") == "" {
+		readCacheTTL = defaultReadCacheTTL
+	}
+	preflight, err := boolOrEnv(config.Preflight, "AUTOGLUE_PREFLIGHT")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("preflight"), "Invalid preflight", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if insecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS certificate verification is disabled",
+			"insecure_skip_verify is set, so the provider accepts any certificate presented by the Autoglue API. "+
+				"Credentials and secrets sent by the provider can be intercepted. Use ca_cert_pem or ca_cert_file instead.",
+		)
+	}
+
 	client, err := newAutoglueClient(clientConfig{
-		BaseURL:     baseURL,
-		OrgID:       orgID,
-		APIKey:      apiKey,
-		OrgKey:      orgKey,
-		OrgSecret:   orgSecret,
-		BearerToken: bearerToken,
+		BaseURL:          baseURL,
+		OrgID:            orgID,
+		APIKey:           apiKey,
+		OrgKey:           orgKey,
+		OrgSecret:        orgSecret,
+		BearerToken:      bearerToken,
+		OIDC:             oidc,
+		RetryMaxAttempts: int(retryMaxAttempts),
+		RetryMaxWait:     retryMaxWait,
+
+		MaxConcurrentRequests: int(maxConcurrentRequests),
+		ReadCacheTTL:          readCacheTTL,
+
+		Transport: transportConfig{
+			CACertPEM:          stringOrEnv(config.CACertPEM, "AUTOGLUE_CA_CERT_PEM"),
+			CACertFile:         stringOrEnv(config.CACertFile, "AUTOGLUE_CA_CERT_FILE"),
+			ClientCert:         stringOrEnv(config.ClientCert, "AUTOGLUE_CLIENT_CERT"),
+			ClientKey:          stringOrEnv(config.ClientKey, "AUTOGLUE_CLIENT_KEY"),
+			InsecureSkipVerify: insecureSkipVerify,
+			ProxyURL:           stringOrEnv(config.ProxyURL, "AUTOGLUE_PROXY_URL"),
+			RequestTimeout:     requestTimeout,
+		},
+		Headers: headers,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create Autoglue client", err.Error())
 		return
 	}
 
+	if preflight {
+		if perr := client.preflight(ctx); perr != nil {
+			resp.Diagnostics.AddAttributeError(perr.attribute, perr.summary, perr.detail)
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Autoglue client configured", map[string]any{
-		"base_url": client.baseURL,
+		"base_url":           client.baseURL,
+		"retry_max_attempts": client.retry.MaxAttempts,
+		"retry_max_wait":     client.retry.MaxWait.String(),
+		"request_timeout":    client.httpClient.Timeout.String(),
+		"server_version":     client.serverVersion,
+		"max_concurrent":     cap(client.limiter),
+		"read_cache_ttl":     client.reads.ttl.String(),
 	})
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *autoglueProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -144,7 +399,12 @@ func (p *autoglueProvider) Resources(_ context.Context) []func() resource.Resour
 		NewClusterBastionResource,
 		NewClusterNodePoolsResource,
 		NewClusterKubeconfigResource,
+		NewClusterAttachmentsResource,
 		NewClusterMetadataResource,
+		NewOrgResource,
+		NewOrgMemberResource,
+		NewOrgAPIKeyResource,
+		NewUserAPIKeyResource,
 	}
 }
 
@@ -162,12 +422,33 @@ func (p *autoglueProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewDomainsDataSource,
 		NewRecordSetsDataSource,
 		NewClustersDataSource,
+		NewOrgsDataSource,
+		NewOrgMembersDataSource,
+		NewOrgAPIKeysDataSource,
+		NewUserAPIKeysDataSource,
+		NewServerDataSource,
+		NewSSHKeyDataSource,
+		NewLoadBalancerDataSource,
+		NewDomainDataSource,
+		NewRecordSetDataSource,
+		NewClusterDataSource,
+		NewClusterStatusDataSource,
+	}
+}
+
+func (p *autoglueProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSSHPrivateKeyEphemeralResource,
 	}
 }
 
 func (p *autoglueProvider) Functions(_ context.Context) []func() function.Function {
-	// No custom functions (yet).
-	return nil
+	return []func() function.Function{
+		NewControlPlaneFQDNFunction,
+		NewParseImportIDFunction,
+		NewTaintStringFunction,
+		NewFingerprintFunction,
+	}
 }
 
 func stringOrEnv(v types.String, envName string) string {
@@ -179,3 +460,75 @@ func stringOrEnv(v types.String, envName string) string {
 	}
 	return ""
 }
+
+// int64OrEnv returns the configured value, falling back to envName. Zero means "unset".
+func int64OrEnv(v types.Int64, envName string) (int64, error) {
+	if !v.IsNull() && !v.IsUnknown() {
+		if v.ValueInt64() < 0 {
+			return 0, fmt.Errorf("must not be negative, got %d", v.ValueInt64())
+		}
+		return v.ValueInt64(), nil
+	}
+	raw := os.Getenv(envName)
+	if raw == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer, got %q", envName, raw)
+	}
+	return n, nil
+}
+
+// durationOrEnv parses a Go duration from the configured value or envName. Zero means "unset".
+func durationOrEnv(v types.String, envName string) (time.Duration, error) {
+	raw := stringOrEnv(v, envName)
+	if raw == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", raw, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration must not be negative, got %q", raw)
+	}
+	return d, nil
+}
+
+// boolOrEnv returns the configured value, falling back to envName.
+func boolOrEnv(v types.Bool, envName string) (bool, error) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueBool(), nil
+	}
+	raw := os.Getenv(envName)
+	if raw == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean, got %q", envName, raw)
+	}
+	return b, nil
+}
+
+// stringMapOrEnv returns the configured map, falling back to comma-separated
+// Name=value pairs in envName.
+func stringMapOrEnv(ctx context.Context, v types.Map, envName string) (map[string]string, error) {
+	if !v.IsNull() && !v.IsUnknown() {
+		out := map[string]string{}
+		if diags := v.ElementsAs(ctx, &out, false); diags.HasError() {
+			return nil, fmt.Errorf("all values must be known strings")
+		}
+		return out, nil
+	}
+	raw := os.Getenv(envName)
+	if raw == "" {
+		return nil, nil
+	}
+	out, err := parseHeaders(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", envName, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &recordSetDataSource{}
+	_ datasource.DataSourceWithConfigure        = &recordSetDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &recordSetDataSource{}
+)
+
+type recordSetDataSource struct {
+	client *autoglueClient
+}
+
+type recordSetDataSourceModel struct {
+	recordSetListItemModel
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func NewRecordSetDataSource() datasource.DataSource {
+	return &recordSetDataSource{}
+}
+
+func (d *recordSetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_record_set"
+}
+
+func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Reads a single DNS record set by ID, or by domain, name and (optionally) type.",
+		Attributes: lookupAttributes(recordSetDataSourceAttributes(), map[string]string{
+			"id":        "Record set ID to look up. Exactly one of `id` or `name` must be set.",
+			"domain_id": "Domain ID the record set belongs to. Required with `name`.",
+			"name": "Record name to look up. May be relative to the domain (e.g. `api`) " +
+				"or fully qualified (e.g. `api.example.com`).",
+			"type": "Optional record type to narrow a lookup by name (A, AAAA, CNAME, TXT, MX, NS, SRV, CAA).",
+		}),
+	}
+}
+
+func (d *recordSetDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		datasourcevalidator.RequiredTogether(path.MatchRoot("domain_id"), path.MatchRoot("name")),
+		datasourcevalidator.Conflicting(path.MatchRoot("id"), path.MatchRoot("type")),
+	}
+}
+
+func (d *recordSetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *recordSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config recordSetDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found recordSet
+	if id := filterValue(config.ID); id != "" {
+		tflog.Info(ctx, "Reading Autoglue record set data source", map[string]any{"id": id})
+
+		apiPath := fmt.Sprintf("/dns/records/%s", id)
+		if err := d.client.doJSON(ctx, http.MethodGet, apiPath, "", nil, &found); err != nil {
+			if isNotFound(err) {
+				err = fmt.Errorf("no record set with id %q found", id)
+			}
+			resp.Diagnostics.AddError("Error reading record set", err.Error())
+			return
+		}
+	} else {
+		domainID := filterValue(config.DomainID)
+		name := filterValue(config.Name)
+		rrType := strings.ToUpper(filterValue(config.Type))
+
+		tflog.Info(ctx, "Looking up Autoglue record set by name", map[string]any{
+			"domain_id": domainID,
+			"name":      name,
+			"type":      rrType,
+		})
+
+		// The domain name is needed to compare relative and fully qualified names.
+		var dom domain
+		if err := d.client.doJSON(ctx, http.MethodGet, fmt.Sprintf("/dns/domains/%s", domainID), "", nil, &dom); err != nil {
+			if isNotFound(err) {
+				err = fmt.Errorf("no domain with id %q found", domainID)
+			}
+			resp.Diagnostics.AddError("Error reading domain", err.Error())
+			return
+		}
+
+		want := relativeRecordName(name, dom.DomainName)
+		q := url.Values{}
+		if want != "@" {
+			q.Set("name", want)
+		}
+		if rrType != "" {
+			q.Set("type", rrType)
+		}
+		items, err := listAll[recordSet](ctx, d.client, fmt.Sprintf("/dns/domains/%s/records", domainID), q)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing record sets", err.Error())
+			return
+		}
+
+		by := fmt.Sprintf("name %q", name)
+		if rrType != "" {
+			by += fmt.Sprintf(" and type %s", rrType)
+		}
+		found, err = findOne(items,
+			func(v recordSet) bool {
+				return relativeRecordName(v.Name, dom.DomainName) == want && matchesExact(config.Type, v.Type)
+			},
+			func(v recordSet) string { return v.ID },
+			"record set", by)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up record set", err.Error())
+			return
+		}
+	}
+
+	item, d2 := recordSetDataSourceItem(ctx, found)
+	resp.Diagnostics.Append(d2...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := recordSetDataSourceModel{item, config.OrgID}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// relativeRecordName normalizes a record name to lowercase and relative to
+// domainName, with "@" for the apex.
+func relativeRecordName(name, domainName string) string {
+	n := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	dn := strings.TrimSuffix(strings.ToLower(domainName), ".")
+	switch {
+	case n == "" || n == "@" || n == dn:
+		return "@"
+	case dn != "" && strings.HasSuffix(n, "."+dn):
+		return strings.TrimSuffix(n, "."+dn)
+	}
+	return n
+}
@@ -3,23 +3,29 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &recordSetResource{}
-	_ resource.ResourceWithConfigure   = &recordSetResource{}
-	_ resource.ResourceWithImportState = &recordSetResource{}
+	_ resource.Resource                   = &recordSetResource{}
+	_ resource.ResourceWithConfigure      = &recordSetResource{}
+	_ resource.ResourceWithImportState    = &recordSetResource{}
+	_ resource.ResourceWithValidateConfig = &recordSetResource{}
 )
 
 type recordSetResource struct {
@@ -39,6 +45,11 @@ type recordSetResourceModel struct {
 	Owner       types.String `tfsdk:"owner"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+
+	WaitForReady types.Bool                 `tfsdk:"wait_for_ready"`
+	Timeouts     *createUpdateTimeoutsModel `tfsdk:"timeouts"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewRecordSetResource() resource.Resource {
@@ -77,9 +88,11 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 
 			"type": resourceschema.StringAttribute{
-				Required: true,
-				Description: "DNS record type (A, AAAA, CNAME, TXT, MX, NS, SRV, CAA). " +
-					"Validation is enforced by the API.",
+				Required:    true,
+				Description: "DNS record type (A, AAAA, CNAME, TXT, MX, NS, SRV, CAA).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(recordSetTypes...),
+				},
 			},
 
 			"ttl": resourceschema.Int64Attribute{
@@ -95,7 +108,9 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"values": resourceschema.ListAttribute{
 				ElementType: types.StringType,
 				Required:    true,
-				Description: "Record values (e.g. IP addresses, hostnames, TXT values).",
+				Description: "Record values. Validated per type: IP addresses for A/AAAA, hostnames for CNAME " +
+					"(exactly one) and NS, `<priority> <host>` for MX, `<priority> <weight> <port> <target>` for SRV, " +
+					"double-quoted strings for TXT and `<flags> <tag> \"<value>\"` for CAA.",
 			},
 
 			"fingerprint": resourceschema.StringAttribute{
@@ -103,6 +118,14 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Deterministic fingerprint for the desired record content.",
 			},
 
+			"wait_for_ready": resourceschema.BoolAttribute{
+				Optional: true,
+				Description: "If true, create and update wait until the record set reports `ready`, polling with " +
+					"backoff up to the matching `timeouts` value. The apply fails with `last_error` if the record set lands on " +
+					"`failed` first. If omitted, the provider does not wait. `fingerprint` is not compared with the " +
+					"desired values, because the API does not document how it is computed.",
+			},
+
 			"status": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Provisioning status (pending, provisioning, ready, failed).",
@@ -127,6 +150,10 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Description: "Last update timestamp (RFC3339).",
 			},
+			"org_id": orgIDResourceAttribute(),
+		},
+		Blocks: map[string]resourceschema.Block{
+			"timeouts": createUpdateTimeoutsBlock(),
 		},
 	}
 }
@@ -159,6 +186,11 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	domainID := plan.DomainID.ValueString()
 	if domainID == "" {
 		resp.Diagnostics.AddError("Missing domain_id", "domain_id must be set.")
@@ -185,6 +217,12 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 		Values: values,
 	}
 
+	timeout, diags := plan.Timeouts.CreateTimeout(defaultRecordSetCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	path := fmt.Sprintf("/dns/domains/%s/records", domainID)
 	tflog.Info(ctx, "Creating Autoglue record set", map[string]any{
 		"domain_id": domainID,
@@ -205,6 +243,15 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State is saved before waiting so a failed or timed-out wait leaves the
+	// record set tracked (and tainted) instead of orphaned.
+	r.waitForReady(ctx, &plan, timeout, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -220,6 +267,11 @@ func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.State.RemoveResource(ctx)
@@ -268,12 +320,23 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Record set ID is required in state to update.")
 		return
 	}
 
+	timeout, diags := plan.Timeouts.UpdateTimeout(defaultRecordSetUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var payload updateRecordSetPayload
 
 	if !plan.Name.Equal(state.Name) {
@@ -305,7 +368,11 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	if payload.Name == nil && payload.Type == nil && payload.TTL == nil && payload.Values == nil {
-		// Nothing to update
+		// Only wait_for_ready or timeouts changed; nothing to send to the API.
+		syncRecordSetModelComputed(&plan, &state)
+		r.waitForReady(ctx, &plan, timeout, &resp.Diagnostics)
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
@@ -326,6 +393,7 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 		resp.Diagnostics.AddError("Error mapping record set response", err.Error())
 		return
 	}
+	r.waitForReady(ctx, &plan, timeout, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -344,6 +412,11 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		return
@@ -361,8 +434,52 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *recordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_record_set.example <record_set_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_record_set.example <record_set_id> or <org_id>/<record_set_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
+}
+
+// waitForReady blocks until the record set is ready, if model.WaitForReady
+// is set, refreshing model from the last poll and reporting failures to diags.
+func (r *recordSetResource) waitForReady(ctx context.Context, model *recordSetResourceModel, timeout time.Duration, diags *diag.Diagnostics) {
+	if !model.WaitForReady.ValueBool() || model.Status.ValueString() == recordSetStatusReady {
+		return
+	}
+
+	id := model.ID.ValueString()
+	tflog.Info(ctx, "Waiting for Autoglue record set to be ready", map[string]any{
+		"id":      id,
+		"timeout": timeout.String(),
+	})
+
+	last, err := r.client.waitForRecordSetReady(ctx, id, timeout)
+	if last != nil {
+		if syncErr := syncRecordSetFromAPI(ctx, model, last); syncErr != nil {
+			diags.AddError("Error mapping record set response", syncErr.Error())
+			return
+		}
+	}
+	switch {
+	case errors.Is(err, errRecordSetFailed):
+		detail := fmt.Sprintf("Record set %s entered status %q while waiting for %q.", id, recordSetStatusFailed, recordSetStatusReady)
+		if last != nil && last.LastError != "" {
+			detail += "\n\nlast_error: " + last.LastError
+		}
+		diags.AddAttributeError(path.Root("status"), "Record set provisioning failed", detail)
+	case err != nil:
+		diags.AddError("Error waiting for record set", err.Error())
+	}
+}
+
+// syncRecordSetModelComputed copies server-populated attributes from src to dst.
+func syncRecordSetModelComputed(dst, src *recordSetResourceModel) {
+	dst.ID = src.ID
+	dst.TTL = src.TTL
+	dst.Fingerprint = src.Fingerprint
+	dst.Status = src.Status
+	dst.LastError = src.LastError
+	dst.Owner = src.Owner
+	dst.CreatedAt = src.CreatedAt
+	dst.UpdatedAt = src.UpdatedAt
 }
 
 func syncRecordSetFromAPI(
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// recordSetTypes are the DNS record types the API accepts.
+var recordSetTypes = []string{"A", "AAAA", "CNAME", "TXT", "MX", "NS", "SRV", "CAA"}
+
+// recordValueFormats describes the expected value format per record type,
+// used in validation errors.
+var recordValueFormats = map[string]string{
+	"A":     "an IPv4 address, e.g. `203.0.113.10`",
+	"AAAA":  "an IPv6 address, e.g. `2001:db8::10`",
+	"CNAME": "a hostname, e.g. `lb.example.net`",
+	"NS":    "a hostname, e.g. `ns1.example.net`",
+	"MX":    "`<priority> <host>`, e.g. `10 mail.example.com`",
+	"SRV":   "`<priority> <weight> <port> <target>`, e.g. `10 5 443 api.example.com`",
+	"TXT":   "one or more double-quoted strings, e.g. `\"v=spf1 -all\"`",
+	"CAA":   "`<flags> <tag> \"<value>\"`, e.g. `0 issue \"letsencrypt.org\"`",
+}
+
+func (r *recordSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var recordType types.String
+	var values types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("values"), &values)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Unknown types are reported by the schema validator; unknown values are
+	// checked at apply time by the API.
+	if recordType.IsNull() || recordType.IsUnknown() || values.IsNull() || values.IsUnknown() {
+		return
+	}
+	typ := recordType.ValueString()
+	if _, ok := recordValueFormats[typ]; !ok {
+		return
+	}
+
+	elems := values.Elements()
+	if typ == "CNAME" && len(elems) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("values"),
+			"Invalid record values",
+			fmt.Sprintf("A CNAME record set must have exactly one value, got %d.", len(elems)),
+		)
+	}
+	for i, elem := range elems {
+		v, ok := elem.(types.String)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if err := validateRecordValue(typ, v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("values").AtListIndex(i),
+				"Invalid record value",
+				fmt.Sprintf("%q is not a valid %s value: %s. Expected %s.", v.ValueString(), typ, err, recordValueFormats[typ]),
+			)
+		}
+	}
+}
+
+// validateRecordValue checks a single value of a record of type typ.
+func validateRecordValue(typ, value string) error {
+	switch typ {
+	case "A", "AAAA":
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return fmt.Errorf("not an IP address")
+		}
+		if typ == "A" && !addr.Is4() {
+			return fmt.Errorf("not an IPv4 address")
+		}
+		if typ == "AAAA" && (!addr.Is6() || addr.Is4In6() || addr.Zone() != "") {
+			return fmt.Errorf("not an IPv6 address")
+		}
+		return nil
+	case "CNAME", "NS":
+		return validateRecordHostname(value)
+	case "MX":
+		fields := strings.Fields(value)
+		if len(fields) != 2 {
+			return fmt.Errorf("expected a priority and a host")
+		}
+		if err := validateRecordUint16("priority", fields[0]); err != nil {
+			return err
+		}
+		return validateRecordHostname(fields[1])
+	case "SRV":
+		fields := strings.Fields(value)
+		if len(fields) != 4 {
+			return fmt.Errorf("expected a priority, weight, port and target")
+		}
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateRecordUint16(name, fields[i]); err != nil {
+				return err
+			}
+		}
+		if fields[3] == "." {
+			// "." means the service is explicitly unavailable.
+			return nil
+		}
+		return validateRecordHostname(fields[3])
+	case "TXT":
+		return validateTXTValue(value)
+	case "CAA":
+		fields := strings.SplitN(strings.TrimSpace(value), " ", 3)
+		if len(fields) != 3 {
+			return fmt.Errorf("expected flags, a tag and a value")
+		}
+		if n, err := strconv.ParseUint(fields[0], 10, 8); err != nil || n > 255 {
+			return fmt.Errorf("flags must be an integer between 0 and 255")
+		}
+		if fields[1] == "" || strings.IndexFunc(fields[1], func(c rune) bool {
+			return !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9')
+		}) >= 0 {
+			return fmt.Errorf("tag must be alphanumeric")
+		}
+		return validateTXTValue(strings.TrimSpace(fields[2]))
+	}
+	return nil
+}
+
+func validateRecordUint16(name, s string) error {
+	if _, err := strconv.ParseUint(s, 10, 16); err != nil {
+		return fmt.Errorf("%s must be an integer between 0 and 65535", name)
+	}
+	return nil
+}
+
+// validateRecordHostname accepts relative or fully qualified hostnames, with
+// or without a trailing dot. Underscores are allowed for service labels.
+func validateRecordHostname(s string) error {
+	name := strings.TrimSuffix(s, ".")
+	if name == "" || len(name) > 253 {
+		return fmt.Errorf("not a valid hostname")
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("not a valid hostname")
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '*') {
+				return fmt.Errorf("not a valid hostname")
+			}
+		}
+	}
+	return nil
+}
+
+// validateTXTValue checks that s is one or more whitespace-separated quoted
+// strings of at most 255 characters each, with `\"` and `\\` escapes.
+func validateTXTValue(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return fmt.Errorf("must not be empty")
+	}
+	for s != "" {
+		if s[0] != '"' {
+			return fmt.Errorf("must be quoted")
+		}
+		n, i, closed := 0, 1, false
+		for i < len(s) {
+			switch s[i] {
+			case '\\':
+				if i+1 >= len(s) {
+					return fmt.Errorf("ends with an incomplete escape")
+				}
+				i += 2
+			case '"':
+				closed = true
+			default:
+				i++
+			}
+			if closed {
+				break
+			}
+			n++
+		}
+		if !closed {
+			return fmt.Errorf("has an unterminated quoted string")
+		}
+		if n > 255 {
+			return fmt.Errorf("quoted strings must be at most 255 characters; split longer values into several")
+		}
+		rest := s[i+1:]
+		trimmed := strings.TrimLeft(rest, " \t")
+		if trimmed != "" && len(trimmed) == len(rest) {
+			return fmt.Errorf("quoted strings must be separated by whitespace")
+		}
+		s = trimmed
+	}
+	return nil
+}
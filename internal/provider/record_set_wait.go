@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	recordSetStatusReady  = "ready"
+	recordSetStatusFailed = "failed"
+
+	defaultRecordSetCreateTimeout = 10 * time.Minute
+	defaultRecordSetUpdateTimeout = 10 * time.Minute
+)
+
+// Poll intervals for record set waits. Variables so tests can shorten them.
+var (
+	recordSetPollInitialInterval = 2 * time.Second
+	recordSetPollMaxInterval     = 15 * time.Second
+)
+
+// errRecordSetFailed is returned by waitForRecordSetReady when the record set
+// lands on "failed".
+var errRecordSetFailed = errors.New("record set provisioning failed")
+
+// waitForRecordSetReady polls GET /dns/records/{id} until the record set is
+// ready, fails, or timeout elapses. The last observed record set is returned
+// in every case so callers can persist it.
+func (c *autoglueClient) waitForRecordSetReady(ctx context.Context, id string, timeout time.Duration) (*recordSet, error) {
+	ctx, cancel := context.WithTimeout(withFreshReads(ctx), timeout)
+	defer cancel()
+
+	path := fmt.Sprintf("/dns/records/%s", id)
+	interval := recordSetPollInitialInterval
+
+	var last *recordSet
+	for {
+		var apiResp recordSet
+		if err := c.doJSON(ctx, http.MethodGet, path, "", nil, &apiResp); err != nil {
+			if ctx.Err() != nil {
+				return last, recordSetWaitTimeoutError(id, timeout, last)
+			}
+			return last, err
+		}
+		last = &apiResp
+
+		tflog.Debug(ctx, "Polled Autoglue record set status", map[string]any{
+			"id":     id,
+			"status": apiResp.Status,
+		})
+
+		switch apiResp.Status {
+		case recordSetStatusFailed:
+			return last, errRecordSetFailed
+		case recordSetStatusReady:
+			return last, nil
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return last, recordSetWaitTimeoutError(id, timeout, last)
+		}
+		interval = min(interval*2, recordSetPollMaxInterval)
+	}
+}
+
+func recordSetWaitTimeoutError(id string, timeout time.Duration, last *recordSet) error {
+	if last == nil {
+		return fmt.Errorf("timed out after %s waiting for record set %s to be ready", timeout, id)
+	}
+	return fmt.Errorf("timed out after %s waiting for record set %s to be ready (last status %q)", timeout, id, last.Status)
+}
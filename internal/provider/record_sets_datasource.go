@@ -4,12 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -30,6 +30,8 @@ type recordSetsDataSourceModel struct {
 	Status   types.String `tfsdk:"status"`
 
 	Records []recordSetListItemModel `tfsdk:"records"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type recordSetListItemModel struct {
@@ -82,59 +84,65 @@ func (d *recordSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:    true,
 				Description: "Matching record sets.",
 				NestedObject: dsschema.NestedAttributeObject{
-					Attributes: map[string]dsschema.Attribute{
-						"id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Record set ID.",
-						},
-						"domain_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Owning domain ID.",
-						},
-						"name": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Record name (relative to domain).",
-						},
-						"type": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Record type.",
-						},
-						"ttl": dsschema.Int64Attribute{
-							Computed:    true,
-							Description: "TTL in seconds.",
-						},
-						"values": dsschema.ListAttribute{
-							ElementType: types.StringType,
-							Computed:    true,
-							Description: "Record values.",
-						},
-						"fingerprint": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Fingerprint of desired state.",
-						},
-						"status": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Provisioning status.",
-						},
-						"last_error": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last provisioning error, if any.",
-						},
-						"owner": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Owner marker.",
-						},
-						"created_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Creation timestamp.",
-						},
-						"updated_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last update timestamp.",
-						},
-					},
+					Attributes: recordSetDataSourceAttributes(),
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// recordSetDataSourceAttributes describes a record set as exposed by the record_set and record_sets data sources.
+func recordSetDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Record set ID.",
+		},
+		"domain_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Owning domain ID.",
+		},
+		"name": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Record name (relative to domain).",
+		},
+		"type": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Record type.",
+		},
+		"ttl": dsschema.Int64Attribute{
+			Computed:    true,
+			Description: "TTL in seconds.",
+		},
+		"values": dsschema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "Record values.",
+		},
+		"fingerprint": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Fingerprint of desired state.",
+		},
+		"status": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Provisioning status.",
+		},
+		"last_error": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last provisioning error, if any.",
+		},
+		"owner": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Owner marker.",
+		},
+		"created_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Creation timestamp.",
+		},
+		"updated_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last update timestamp.",
 		},
 	}
 }
@@ -167,6 +175,11 @@ func (d *recordSetsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	domainID := config.DomainID.ValueString()
 	if domainID == "" {
 		resp.Diagnostics.AddError("Missing domain_id", "domain_id must be set.")
@@ -192,50 +205,65 @@ func (d *recordSetsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		"query":     query,
 	})
 
-	var apiResp []recordSet
-	if err := d.client.doJSON(ctx, http.MethodGet, path, query, nil, &apiResp); err != nil {
+	apiResp, err := listAll[recordSet](ctx, d.client, path, q)
+	if err != nil {
 		resp.Diagnostics.AddError("Error listing record sets", err.Error())
 		return
 	}
 
 	config.Records = make([]recordSetListItemModel, 0, len(apiResp))
 	for _, rs := range apiResp {
-		item := recordSetListItemModel{
-			ID:          types.StringValue(rs.ID),
-			DomainID:    types.StringValue(rs.DomainID),
-			Name:        types.StringValue(rs.Name),
-			Type:        types.StringValue(rs.Type),
-			Fingerprint: types.StringValue(rs.Fingerprint),
-			Status:      types.StringValue(rs.Status),
-			LastError:   types.StringValue(rs.LastError),
-			Owner:       types.StringValue(rs.Owner),
-			CreatedAt:   types.StringValue(rs.CreatedAt),
-			UpdatedAt:   types.StringValue(rs.UpdatedAt),
-		}
-
-		if rs.TTL != nil {
-			item.TTL = types.Int64Value(int64(*rs.TTL))
-		} else {
-			item.TTL = types.Int64Null()
+		// name is normalized server-side (relative vs FQDN), so only the
+		// exact-match filters are re-checked here, normalized as sent.
+		if !matchesExact(types.StringValue(q.Get("type")), rs.Type) ||
+			!matchesExact(types.StringValue(q.Get("status")), rs.Status) {
+			continue
 		}
 
-		var vals []string
-		if len(rs.Values) > 0 && string(rs.Values) != "null" {
-			if err := json.Unmarshal(rs.Values, &vals); err != nil {
-				resp.Diagnostics.AddError("Error decoding record set values", err.Error())
-				return
-			}
-		}
-		listVal, d2 := types.ListValueFrom(ctx, types.StringType, vals)
+		item, d2 := recordSetDataSourceItem(ctx, rs)
 		resp.Diagnostics.Append(d2...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		item.Values = listVal
-
 		config.Records = append(config.Records, item)
 	}
 
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 }
+
+// recordSetDataSourceItem converts an API record set into its data source representation.
+func recordSetDataSourceItem(ctx context.Context, rs recordSet) (recordSetListItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	item := recordSetListItemModel{
+		ID:          types.StringValue(rs.ID),
+		DomainID:    types.StringValue(rs.DomainID),
+		Name:        types.StringValue(rs.Name),
+		Type:        types.StringValue(rs.Type),
+		Fingerprint: types.StringValue(rs.Fingerprint),
+		Status:      types.StringValue(rs.Status),
+		LastError:   types.StringValue(rs.LastError),
+		Owner:       types.StringValue(rs.Owner),
+		CreatedAt:   types.StringValue(rs.CreatedAt),
+		UpdatedAt:   types.StringValue(rs.UpdatedAt),
+	}
+
+	if rs.TTL != nil {
+		item.TTL = types.Int64Value(int64(*rs.TTL))
+	} else {
+		item.TTL = types.Int64Null()
+	}
+
+	var vals []string
+	if len(rs.Values) > 0 && string(rs.Values) != "null" {
+		if err := json.Unmarshal(rs.Values, &vals); err != nil {
+			diags.AddError("Error decoding record set values", err.Error())
+			return item, diags
+		}
+	}
+	listVal, d := types.ListValueFrom(ctx, types.StringType, vals)
+	diags.Append(d...)
+	item.Values = listVal
+
+	return item, diags
+}
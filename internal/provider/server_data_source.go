@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &serverDataSource{}
+	_ datasource.DataSourceWithConfigure        = &serverDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &serverDataSource{}
+)
+
+type serverDataSource struct {
+	client *autoglueClient
+}
+
+type serverDataSourceModel struct {
+	serversDataSourceServerModel
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func NewServerDataSource() datasource.DataSource {
+	return &serverDataSource{}
+}
+
+func (d *serverDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Reads a single Autoglue server by ID or hostname.",
+		Attributes: lookupAttributes(serverDataSourceAttributes(), map[string]string{
+			"id":       "Server ID to look up. Exactly one of `id` or `hostname` must be set.",
+			"hostname": "Server hostname to look up. Must match exactly one server.",
+		}),
+	}
+}
+
+func (d *serverDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("hostname")),
+	}
+}
+
+func (d *serverDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config serverDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found server
+	if id := filterValue(config.ID); id != "" {
+		tflog.Info(ctx, "Reading Autoglue server data source", map[string]any{"id": id})
+
+		apiPath := fmt.Sprintf("/servers/%s", id)
+		if err := d.client.doJSON(ctx, http.MethodGet, apiPath, "", nil, &found); err != nil {
+			if isNotFound(err) {
+				err = fmt.Errorf("no server with id %q found", id)
+			}
+			resp.Diagnostics.AddError("Error reading server", err.Error())
+			return
+		}
+	} else {
+		hostname := filterValue(config.Hostname)
+		tflog.Info(ctx, "Looking up Autoglue server by hostname", map[string]any{"hostname": hostname})
+
+		servers, err := listAll[server](ctx, d.client, "/servers", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing servers", err.Error())
+			return
+		}
+		found, err = findOne(servers,
+			func(s server) bool { return matchesExact(config.Hostname, s.Hostname) },
+			func(s server) string { return s.ID },
+			"server", fmt.Sprintf("hostname %q", hostname))
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up server", err.Error())
+			return
+		}
+	}
+
+	state := serverDataSourceModel{serverDataSourceItem(found), config.OrgID}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -33,6 +33,7 @@ type serverResourceModel struct {
 	SSHKeyID         types.String `tfsdk:"ssh_key_id"`
 	SSHUser          types.String `tfsdk:"ssh_user"`
 	OrganizationID   types.String `tfsdk:"organization_id"`
+	OrgID            types.String `tfsdk:"org_id"`
 	Status           types.String `tfsdk:"status"`
 	CreatedAt        types.String `tfsdk:"created_at"`
 	UpdatedAt        types.String `tfsdk:"updated_at"`
@@ -90,6 +91,7 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "SSH username used to access this server.",
 			},
 
+			"org_id": orgIDResourceAttribute(),
 			"organization_id": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -144,6 +146,11 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := createServerPayload{
 		Hostname:         plan.Hostname.ValueString(),
 		Role:             plan.Role.ValueString(),
@@ -187,6 +194,11 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Server ID is required in state to read server.")
@@ -234,6 +246,11 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Server ID is required in state to update server.")
@@ -286,6 +303,11 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		// Nothing to do.
@@ -306,17 +328,17 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Support: terraform import autoglue_server.example <server_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Support: terraform import autoglue_server.example <server_id> or <org_id>/<server_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
 }
 
 // Helper: copy API struct -> Terraform model
 func mapServerAPIToModel(m *serverResourceModel, s *server) {
 	m.ID = types.StringValue(s.ID)
 	m.Hostname = types.StringValue(s.Hostname)
-	m.Role = types.StringValue(s.Role)
-	m.PrivateIPAddress = types.StringValue(s.PrivateIPAddress)
-	m.PublicIPAddress = types.StringValue(s.PublicIPAddress)
+	m.Role = stringValueOrNull(s.Role)
+	m.PrivateIPAddress = stringValueOrNull(s.PrivateIPAddress)
+	m.PublicIPAddress = stringValueOrNull(s.PublicIPAddress)
 	m.SSHKeyID = types.StringValue(s.SSHKeyID)
 	m.SSHUser = types.StringValue(s.SSHUser)
 	m.OrganizationID = types.StringValue(s.OrganizationID)
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestServerResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "servers"})
+
+	srv := h.resource("autoglue_server")
+	config := map[string]any{
+		"hostname":           "worker-1",
+		"role":               "worker",
+		"private_ip_address": "10.0.0.10",
+		"ssh_key_id":         key.Attr("id"),
+		"ssh_user":           "ubuntu",
+	}
+	srv.Apply(config)
+
+	if got := srv.Attr("status"); got != "pending" {
+		t.Errorf("status = %q, want pending", got)
+	}
+	id := srv.Attr("id")
+
+	config["hostname"] = "worker-1a"
+	config["public_ip_address"] = "203.0.113.10"
+	srv.Apply(config)
+
+	if srv.Attr("id") != id {
+		t.Errorf("update replaced the server")
+	}
+	if got := api.servers[id]; got.Hostname != "worker-1a" || got.PublicIPAddress != "203.0.113.10" {
+		t.Errorf("API server not updated: %+v", got)
+	}
+
+	srv.ImportVerify(id)
+
+	list := h.ReadDataSource("autoglue_servers", nil)
+	if got := len(objectElems(t, list["servers"])); got != 1 {
+		t.Errorf("servers data source returned %d servers, want 1", got)
+	}
+
+	byHostname := h.ReadDataSource("autoglue_server", map[string]any{"hostname": "worker-1a"})
+	if got := valueString(t, byHostname["id"]); got != id {
+		t.Errorf("server data source by hostname returned id %q, want %q", got, id)
+	}
+	byID := h.ReadDataSource("autoglue_server", map[string]any{"id": id})
+	if got := valueString(t, byID["public_ip_address"]); got != "203.0.113.10" {
+		t.Errorf("server data source by id returned public_ip_address %q", got)
+	}
+
+	srv.Destroy()
+	key.Destroy()
+	if len(api.servers) != 0 {
+		t.Errorf("expected server to be deleted")
+	}
+}
+
+func TestServerResource_RemovedOutOfBand(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "servers"})
+
+	srv := h.resource("autoglue_server")
+	srv.Apply(map[string]any{
+		"hostname":   "bastion",
+		"role":       "bastion",
+		"ssh_key_id": key.Attr("id"),
+		"ssh_user":   "ubuntu",
+	})
+
+	delete(api.servers, srv.Attr("id"))
+	if !srv.Gone() {
+		t.Fatalf("expected refresh to drop a server deleted outside Terraform")
+	}
+}
+
+func TestServersDataSource_FiltersAndPagination(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "servers"})
+
+	for _, s := range []struct{ hostname, role string }{
+		{"worker-1", "worker"},
+		{"worker-2", "worker"},
+		{"edge-1", "worker"},
+		{"master-1", "master"},
+	} {
+		h.resource("autoglue_server").Apply(map[string]any{
+			"hostname":           s.hostname,
+			"role":               s.role,
+			"private_ip_address": "10.0.0.10",
+			"ssh_key_id":         key.Attr("id"),
+			"ssh_user":           "ubuntu",
+		})
+	}
+
+	api.listPageSize = 1
+	list := h.ReadDataSource("autoglue_servers", map[string]any{
+		"role":            "worker",
+		"hostname_prefix": "WORKER-",
+	})
+
+	var hostnames []string
+	for _, s := range objectElems(t, list["servers"]) {
+		hostnames = append(hostnames, valueString(t, s["hostname"]))
+	}
+	if !reflect.DeepEqual(hostnames, []string{"worker-1", "worker-2"}) {
+		t.Errorf("servers data source returned %v, want [worker-1 worker-2]", hostnames)
+	}
+
+	var pages []string
+	for _, r := range api.Requests() {
+		if strings.HasPrefix(r, "GET /servers?") {
+			pages = append(pages, r)
+		}
+	}
+	want := []string{
+		"GET /servers?role=worker",
+		"GET /servers?page=2&role=worker",
+		"GET /servers?page=3&role=worker",
+	}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("list requests = %v, want %v", pages, want)
+	}
+}
+
+func TestServerDataSource_LookupErrors(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{"name": "servers"})
+	for i := 0; i < 2; i++ {
+		h.resource("autoglue_server").Apply(map[string]any{
+			"hostname":           "dup",
+			"private_ip_address": "10.0.0.10",
+			"ssh_key_id":         key.Attr("id"),
+			"ssh_user":           "ubuntu",
+		})
+	}
+
+	h.ReadDataSourceExpectError("autoglue_server", map[string]any{"hostname": "dup"}, "is ambiguous: it matches 2 objects")
+	h.ReadDataSourceExpectError("autoglue_server", map[string]any{"hostname": "missing"}, `no server with hostname "missing" found`)
+	h.ReadDataSourceExpectError("autoglue_server", map[string]any{"id": "00000000-0000-4000-8000-999999999999"}, "no server with id")
+	h.ReadDataSourceExpectError("autoglue_server", map[string]any{}, "Exactly one of these attributes must be configured")
+	h.ReadDataSourceExpectError("autoglue_server", map[string]any{"id": "x", "hostname": "dup"}, "Invalid Attribute Combination")
+}
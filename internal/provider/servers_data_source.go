@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,7 +21,13 @@ type serversDataSource struct {
 }
 
 type serversDataSourceModel struct {
+	Role           types.String `tfsdk:"role"`
+	Status         types.String `tfsdk:"status"`
+	HostnamePrefix types.String `tfsdk:"hostname_prefix"`
+
 	Servers []serversDataSourceServerModel `tfsdk:"servers"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type serversDataSourceServerModel struct {
@@ -49,60 +55,79 @@ func (d *serversDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 func (d *serversDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Lists all servers visible to the organization.",
+		Description: "Lists servers visible to the organization, optionally filtered by role, status or hostname.",
 		Attributes: map[string]dsschema.Attribute{
+			"role": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional role filter (for example `master`, `worker`, `bastion`).",
+			},
+			"status": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional status filter.",
+			},
+			"hostname_prefix": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Optional case-insensitive hostname prefix filter (applied client-side).",
+			},
+
 			"servers": dsschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "All servers visible to the organization.",
+				Description: "Matching servers.",
 				NestedObject: dsschema.NestedAttributeObject{
-					Attributes: map[string]dsschema.Attribute{
-						"id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Server ID.",
-						},
-						"hostname": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Server hostname.",
-						},
-						"role": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Server role (for example `master`, `worker`, `bastion`).",
-						},
-						"private_ip_address": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Private IP address.",
-						},
-						"public_ip_address": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Public IP address.",
-						},
-						"ssh_key_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "SSH key ID associated with this server.",
-						},
-						"ssh_user": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "SSH username.",
-						},
-						"organization_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Owning organization UUID.",
-						},
-						"status": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Server status as reported by Autoglue.",
-						},
-						"created_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Creation timestamp.",
-						},
-						"updated_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last update timestamp.",
-						},
-					},
+					Attributes: serverDataSourceAttributes(),
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// serverDataSourceAttributes describes a server as exposed by the server and servers data sources.
+func serverDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Server ID.",
+		},
+		"hostname": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Server hostname.",
+		},
+		"role": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Server role (for example `master`, `worker`, `bastion`).",
+		},
+		"private_ip_address": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Private IP address.",
+		},
+		"public_ip_address": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Public IP address.",
+		},
+		"ssh_key_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "SSH key ID associated with this server.",
+		},
+		"ssh_user": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "SSH username.",
+		},
+		"organization_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Owning organization UUID.",
+		},
+		"status": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Server status as reported by Autoglue.",
+		},
+		"created_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Creation timestamp.",
+		},
+		"updated_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last update timestamp.",
 		},
 	}
 }
@@ -130,34 +155,59 @@ func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	tflog.Info(ctx, "Listing Autoglue servers")
+	var config serversDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	var apiResp []server
-	if err := d.client.doJSON(ctx, http.MethodGet, "/servers", "", nil, &apiResp); err != nil {
-		resp.Diagnostics.AddError("Error listing servers", err.Error())
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state := serversDataSourceModel{
-		Servers: make([]serversDataSourceServerModel, 0, len(apiResp)),
+	q := url.Values{}
+	setQueryFilter(q, "role", config.Role)
+	setQueryFilter(q, "status", config.Status)
+
+	tflog.Info(ctx, "Listing Autoglue servers", map[string]any{
+		"query": q.Encode(),
+	})
+
+	apiResp, err := listAll[server](ctx, d.client, "/servers", q)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing servers", err.Error())
+		return
 	}
 
+	config.Servers = make([]serversDataSourceServerModel, 0, len(apiResp))
 	for _, s := range apiResp {
-		state.Servers = append(state.Servers, serversDataSourceServerModel{
-			ID:               types.StringValue(s.ID),
-			Hostname:         types.StringValue(s.Hostname),
-			Role:             types.StringValue(s.Role),
-			PrivateIPAddress: types.StringValue(s.PrivateIPAddress),
-			PublicIPAddress:  types.StringValue(s.PublicIPAddress),
-			SSHKeyID:         types.StringValue(s.SSHKeyID),
-			SSHUser:          types.StringValue(s.SSHUser),
-			OrganizationID:   types.StringValue(s.OrganizationID),
-			Status:           types.StringValue(s.Status),
-			CreatedAt:        types.StringValue(s.CreatedAt),
-			UpdatedAt:        types.StringValue(s.UpdatedAt),
-		})
+		if !matchesExact(config.Role, s.Role) ||
+			!matchesExact(config.Status, s.Status) ||
+			!matchesPrefix(config.HostnamePrefix, s.Hostname) {
+			continue
+		}
+		config.Servers = append(config.Servers, serverDataSourceItem(s))
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 }
+
+// serverDataSourceItem converts an API server into its data source representation.
+func serverDataSourceItem(s server) serversDataSourceServerModel {
+	return serversDataSourceServerModel{
+		ID:               types.StringValue(s.ID),
+		Hostname:         types.StringValue(s.Hostname),
+		Role:             types.StringValue(s.Role),
+		PrivateIPAddress: types.StringValue(s.PrivateIPAddress),
+		PublicIPAddress:  types.StringValue(s.PublicIPAddress),
+		SSHKeyID:         types.StringValue(s.SSHKeyID),
+		SSHUser:          types.StringValue(s.SSHUser),
+		OrganizationID:   types.StringValue(s.OrganizationID),
+		Status:           types.StringValue(s.Status),
+		CreatedAt:        types.StringValue(s.CreatedAt),
+		UpdatedAt:        types.StringValue(s.UpdatedAt),
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &sshKeyDataSource{}
+	_ datasource.DataSourceWithConfigure        = &sshKeyDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &sshKeyDataSource{}
+)
+
+type sshKeyDataSource struct {
+	client *autoglueClient
+}
+
+type sshKeyDataSourceModel struct {
+	sshKeyModel
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func NewSSHKeyDataSource() datasource.DataSource {
+	return &sshKeyDataSource{}
+}
+
+func (d *sshKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (d *sshKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Reads a single Autoglue SSH key by ID or name.",
+		Attributes: lookupAttributes(sshKeyDataSourceAttributes(), map[string]string{
+			"id":   "SSH key ID to look up. Exactly one of `id` or `name` must be set.",
+			"name": "SSH key name to look up. Must match exactly one SSH key.",
+		}),
+	}
+}
+
+func (d *sshKeyDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *sshKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sshKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config sshKeyDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found sshKey
+	if id := filterValue(config.ID); id != "" {
+		tflog.Info(ctx, "Reading Autoglue SSH key data source", map[string]any{"id": id})
+
+		apiPath := fmt.Sprintf("/ssh/%s", id)
+		if err := d.client.doJSON(ctx, http.MethodGet, apiPath, "", nil, &found); err != nil {
+			if isNotFound(err) {
+				err = fmt.Errorf("no SSH key with id %q found", id)
+			}
+			resp.Diagnostics.AddError("Error reading SSH key", err.Error())
+			return
+		}
+	} else {
+		name := filterValue(config.Name)
+		tflog.Info(ctx, "Looking up Autoglue SSH key by name", map[string]any{"name": name})
+
+		items, err := listAll[sshKey](ctx, d.client, "/ssh", url.Values{"name": {name}})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing SSH keys", err.Error())
+			return
+		}
+		found, err = findOne(items,
+			func(v sshKey) bool { return matchesExact(config.Name, v.Name) },
+			func(v sshKey) string { return v.ID },
+			"SSH key", fmt.Sprintf("name %q", name))
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up SSH key", err.Error())
+			return
+		}
+	}
+
+	state := sshKeyDataSourceModel{sshKeyDataSourceItem(found), config.OrgID}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
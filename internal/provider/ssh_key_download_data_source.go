@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -25,6 +24,8 @@ type sshKeyDownloadModel struct {
 	ID      types.String `tfsdk:"id"`
 	Part    types.String `tfsdk:"part"`
 	Content types.String `tfsdk:"content"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewSSHKeyDownloadDataSource() datasource.DataSource {
@@ -37,7 +38,8 @@ func (d *sshKeyDownloadDataSource) Metadata(_ context.Context, req datasource.Me
 
 func (d *sshKeyDownloadDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Downloads SSH key files by ID. WARNING: this can place private key material into Terraform state.",
+		Description: "Downloads SSH key files by ID. WARNING: this can place private key material into Terraform state; " +
+			"use the `autoglue_ssh_private_key` ephemeral resource instead where possible.",
 		Attributes: map[string]dsschema.Attribute{
 			"id": dsschema.StringAttribute{
 				Required:    true,
@@ -52,6 +54,7 @@ func (d *sshKeyDownloadDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:    true,
 				Description: "File content returned by the server (format depends on API; often a ZIP or PEM).",
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -86,6 +89,11 @@ func (d *sshKeyDownloadDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := config.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "id must be set to download an SSH key.")
@@ -107,7 +115,7 @@ func (d *sshKeyDownloadDataSource) Read(ctx context.Context, req datasource.Read
 	})
 
 	var content string
-	if err := d.client.doJSON(ctx, http.MethodGet, path, query, nil, &content); err != nil {
+	if err := d.client.getUncached(ctx, path, query, &content); err != nil {
 		resp.Diagnostics.AddError("Error downloading SSH key", err.Error())
 		return
 	}
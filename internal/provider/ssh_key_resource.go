@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -34,6 +35,7 @@ type sshKeyResourceModel struct {
 	Fingerprint    types.String `tfsdk:"fingerprint"`
 	PublicKey      types.String `tfsdk:"public_key"`
 	OrganizationID types.String `tfsdk:"organization_id"`
+	OrgID          types.String `tfsdk:"org_id"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
 }
@@ -60,21 +62,33 @@ func (r *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"name": resourceschema.StringAttribute{
 				Required:    true,
 				Description: "Human-readable SSH key name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"type": resourceschema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
 				Description: `"rsa" (default) or "ed25519".`,
 				Default:     stringdefault.StaticString("rsa"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"bits": resourceschema.Int64Attribute{
 				Optional: true,
 				Description: "Key size in bits (RSA only; typically 2048, 3072, or 4096). " +
 					"Ignored for ED25519.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"comment": resourceschema.StringAttribute{
 				Optional:    true,
 				Description: "Optional key comment (e.g. deploy@autoglue).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"fingerprint": resourceschema.StringAttribute{
 				Computed:    true,
@@ -84,6 +98,7 @@ func (r *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:    true,
 				Description: "OpenSSH-formatted public key.",
 			},
+			"org_id": orgIDResourceAttribute(),
 			"organization_id": resourceschema.StringAttribute{
 				Computed:    true,
 				Description: "Owning organization UUID.",
@@ -130,6 +145,11 @@ func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var bitsPtr *int
 	if !plan.Bits.IsNull() && !plan.Bits.IsUnknown() {
 		b := int(plan.Bits.ValueInt64())
@@ -185,6 +205,11 @@ func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "SSH key ID is required in state to read an SSH key.")
@@ -218,9 +243,8 @@ func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *sshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// There is no update endpoint for SSH keys in the API, so treat this as
-	// "recreate on change" by leaving this method unimplemented. Framework will
-	// force recreation when any mutable attribute changes.
+	// There is no update endpoint for SSH keys in the API; every configurable
+	// attribute is RequiresReplace, so this only runs for computed-only changes.
 	var state sshKeyResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -245,6 +269,11 @@ func (r *sshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		// Nothing to do
@@ -265,6 +294,6 @@ func (r *sshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *sshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// terraform import autoglue_ssh_key.my_key <ssh_key_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// terraform import autoglue_ssh_key.my_key <ssh_key_id> or <org_id>/<ssh_key_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
 }
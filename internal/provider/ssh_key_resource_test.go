@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSSHKeyResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	key := h.resource("autoglue_ssh_key")
+	key.Apply(map[string]any{
+		"name":    "deploy",
+		"type":    "ed25519",
+		"comment": "deploy@autoglue",
+	})
+
+	if got := key.Attr("name"); got != "deploy" {
+		t.Errorf("name = %q, want deploy", got)
+	}
+	if got := key.Attr("public_key"); !strings.HasPrefix(got, "ssh-ed25519 ") {
+		t.Errorf("public_key = %q, want ssh-ed25519 prefix", got)
+	}
+	if key.Attr("fingerprint") == "" || key.Attr("organization_id") != api.orgID {
+		t.Errorf("fingerprint/organization_id not populated: %v", key.Attrs())
+	}
+
+	key.ImportVerify(key.Attr("id"), "bits", "comment", "type")
+
+	// SSH keys cannot be updated in place.
+	key.ExpectReplace(map[string]any{"name": "deploy-2", "type": "ed25519"})
+
+	download := h.ReadDataSource("autoglue_ssh_key_download", map[string]any{
+		"id":   key.Attr("id"),
+		"part": "public",
+	})
+	if got := valueString(t, download["content"]); got != key.Attr("public_key") {
+		t.Errorf("download content = %q, want public key", got)
+	}
+
+	private := h.OpenEphemeralResource("autoglue_ssh_private_key", map[string]any{"id": key.Attr("id")})
+	if got := valueString(t, private["private_key"]); got != api.sshKeys[key.Attr("id")].privateKey {
+		t.Errorf("ephemeral private_key = %q, want the key's private key", got)
+	}
+	if got := valueString(t, private["public_key"]); got != key.Attr("public_key") {
+		t.Errorf("ephemeral public_key = %q, want %q", got, key.Attr("public_key"))
+	}
+	h.OpenEphemeralResourceExpectError("autoglue_ssh_private_key", map[string]any{"id": "missing"}, `no SSH key with id "missing" found`)
+
+	byName := h.ReadDataSource("autoglue_ssh_key", map[string]any{"name": "deploy"})
+	if got := valueString(t, byName["fingerprint"]); got != key.Attr("fingerprint") {
+		t.Errorf("ssh_key data source by name returned fingerprint %q, want %q", got, key.Attr("fingerprint"))
+	}
+
+	key.Destroy()
+	if len(api.sshKeys) != 0 {
+		t.Errorf("expected SSH key to be deleted, %d left", len(api.sshKeys))
+	}
+}
@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,7 +21,11 @@ type sshKeysDataSource struct {
 }
 
 type sshKeysDataSourceModel struct {
+	Name types.String  `tfsdk:"name"`
+	Q    types.String  `tfsdk:"q"`
 	Keys []sshKeyModel `tfsdk:"keys"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type sshKeyModel struct {
@@ -44,44 +48,58 @@ func (d *sshKeysDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 func (d *sshKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Lists all SSH keys for the organization.",
+		Description: "Lists SSH keys for the organization, optionally filtered by name or query string.",
 		Attributes: map[string]dsschema.Attribute{
+			"name": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Exact SSH key name to filter by.",
+			},
+			"q": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Case-insensitive substring match on name or fingerprint.",
+			},
 			"keys": dsschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "All SSH keys visible to the organization.",
+				Description: "Matching SSH keys.",
 				NestedObject: dsschema.NestedAttributeObject{
-					Attributes: map[string]dsschema.Attribute{
-						"id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "SSH key ID.",
-						},
-						"name": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "SSH key name.",
-						},
-						"fingerprint": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "SSH key fingerprint.",
-						},
-						"public_key": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "OpenSSH-formatted public key.",
-						},
-						"organization_id": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Owning organization UUID.",
-						},
-						"created_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Creation timestamp.",
-						},
-						"updated_at": dsschema.StringAttribute{
-							Computed:    true,
-							Description: "Last update timestamp.",
-						},
-					},
+					Attributes: sshKeyDataSourceAttributes(),
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// sshKeyDataSourceAttributes describes an SSH key as exposed by the ssh_key and ssh_keys data sources.
+func sshKeyDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "SSH key ID.",
+		},
+		"name": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "SSH key name.",
+		},
+		"fingerprint": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "SSH key fingerprint.",
+		},
+		"public_key": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "OpenSSH-formatted public key.",
+		},
+		"organization_id": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Owning organization UUID.",
+		},
+		"created_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Creation timestamp.",
+		},
+		"updated_at": dsschema.StringAttribute{
+			Computed:    true,
+			Description: "Last update timestamp.",
 		},
 	}
 }
@@ -103,36 +121,59 @@ func (d *sshKeysDataSource) Configure(_ context.Context, req datasource.Configur
 	d.client = client
 }
 
-func (d *sshKeysDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *sshKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	if d.client == nil {
 		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
 		return
 	}
 
-	tflog.Info(ctx, "Listing Autoglue SSH keys")
+	var state sshKeysDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	var apiResp []sshKey
-	if err := d.client.doJSON(ctx, http.MethodGet, "/ssh", "", nil, &apiResp); err != nil {
-		resp.Diagnostics.AddError("Error listing SSH keys", err.Error())
+	ctx = d.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state := sshKeysDataSourceModel{
-		Keys: make([]sshKeyModel, len(apiResp)),
+	q := url.Values{}
+	setQueryFilter(q, "name", state.Name)
+	setQueryFilter(q, "q", state.Q)
+
+	tflog.Info(ctx, "Listing Autoglue SSH keys", map[string]any{
+		"query": q.Encode(),
+	})
+
+	apiResp, err := listAll[sshKey](ctx, d.client, "/ssh", q)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing SSH keys", err.Error())
+		return
 	}
 
-	for i, k := range apiResp {
-		state.Keys[i] = sshKeyModel{
-			ID:             types.StringValue(k.ID),
-			Name:           types.StringValue(k.Name),
-			Fingerprint:    types.StringValue(k.Fingerprint),
-			PublicKey:      types.StringValue(k.PublicKey),
-			OrganizationID: types.StringValue(k.OrganizationID),
-			CreatedAt:      types.StringValue(k.CreatedAt),
-			UpdatedAt:      types.StringValue(k.UpdatedAt),
+	state.Keys = make([]sshKeyModel, 0, len(apiResp))
+	for _, k := range apiResp {
+		if !matchesExact(state.Name, k.Name) || !matchesSubstring(state.Q, k.Name, k.Fingerprint) {
+			continue
 		}
+		state.Keys = append(state.Keys, sshKeyDataSourceItem(k))
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// sshKeyDataSourceItem converts an API SSH key into its data source representation.
+func sshKeyDataSourceItem(k sshKey) sshKeyModel {
+	return sshKeyModel{
+		ID:             types.StringValue(k.ID),
+		Name:           types.StringValue(k.Name),
+		Fingerprint:    types.StringValue(k.Fingerprint),
+		PublicKey:      types.StringValue(k.PublicKey),
+		OrganizationID: types.StringValue(k.OrganizationID),
+		CreatedAt:      types.StringValue(k.CreatedAt),
+		UpdatedAt:      types.StringValue(k.UpdatedAt),
+	}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &sshPrivateKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &sshPrivateKeyEphemeralResource{}
+)
+
+type sshPrivateKeyEphemeralResource struct {
+	client *autoglueClient
+}
+
+type sshPrivateKeyEphemeralModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func NewSSHPrivateKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &sshPrivateKeyEphemeralResource{}
+}
+
+func (e *sshPrivateKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_private_key"
+}
+
+func (e *sshPrivateKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ephemeralschema.Schema{
+		Description: "Fetches the private key of an Autoglue SSH key on demand. " +
+			"Unlike the `autoglue_ssh_key_download` data source, the key is never written to plan or state. " +
+			"Requires Terraform 1.10 or later.",
+		Attributes: map[string]ephemeralschema.Attribute{
+			"id": ephemeralschema.StringAttribute{
+				Required:    true,
+				Description: "SSH key ID.",
+			},
+			"name": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "SSH key name.",
+			},
+			"fingerprint": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "SSH key fingerprint.",
+			},
+			"public_key": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "Public key in OpenSSH authorized_keys format.",
+			},
+			"private_key": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Private key in PEM/OpenSSH format.",
+			},
+			"org_id": orgIDEphemeralAttribute(),
+		},
+	}
+}
+
+func (e *sshPrivateKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *sshPrivateKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config sshPrivateKeyEphemeralModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = e.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueString()
+	tflog.Info(ctx, "Fetching Autoglue SSH private key", map[string]any{"id": id})
+
+	var key sshKey
+	if err := e.client.doJSON(ctx, http.MethodGet, fmt.Sprintf("/ssh/%s", id), "", nil, &key); err != nil {
+		if isNotFound(err) {
+			err = fmt.Errorf("no SSH key with id %q found", id)
+		}
+		resp.Diagnostics.AddError("Error reading SSH key", err.Error())
+		return
+	}
+
+	q := url.Values{}
+	q.Set("part", "private")
+
+	var privateKey string
+	if err := e.client.getUncached(ctx, fmt.Sprintf("/ssh/%s/download", id), q.Encode(), &privateKey); err != nil {
+		resp.Diagnostics.AddError("Error downloading SSH private key", err.Error())
+		return
+	}
+
+	config.Name = types.StringValue(key.Name)
+	config.Fingerprint = types.StringValue(key.Fingerprint)
+	config.PublicKey = types.StringValue(key.PublicKey)
+	config.PrivateKey = types.StringValue(privateKey)
+
+	diags = resp.Result.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
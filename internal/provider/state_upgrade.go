@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// setAttributesUpgrader returns a state upgrader that keeps the prior state
+// as is, except that each attribute in values is set to the JSON encoding of
+// its value (and added if it didn't exist).
+func setAttributesUpgrader(values map[string]any) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || len(req.RawState.JSON) == 0 {
+				resp.Diagnostics.AddError("Unable to upgrade state", "The prior state is empty or not in JSON format.")
+				return
+			}
+
+			var raw map[string]json.RawMessage
+			if err := json.Unmarshal(req.RawState.JSON, &raw); err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade state", "Decoding prior state: "+err.Error())
+				return
+			}
+			for name, v := range values {
+				b, err := json.Marshal(v)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", "Encoding "+name+": "+err.Error())
+					return
+				}
+				raw[name] = b
+			}
+
+			upgraded, err := json.Marshal(raw)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade state", "Encoding upgraded state: "+err.Error())
+				return
+			}
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
@@ -31,6 +31,8 @@ type taintResourceModel struct {
 	Effect    types.String `tfsdk:"effect"`
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 func NewTaintResource() resource.Resource {
@@ -81,6 +83,7 @@ func (r *taintResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Description: "Last update timestamp.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
@@ -115,6 +118,11 @@ func (r *taintResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	payload := createTaintPayload{
 		Key:    plan.Key.ValueString(),
 		Value:  stringPointerFromAttr(plan.Value),
@@ -161,6 +169,11 @@ func (r *taintResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Taint ID is required in state to read taint.")
@@ -218,6 +231,11 @@ func (r *taintResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &plan.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError("Missing ID", "Taint ID is required in state to update taint.")
@@ -273,6 +291,11 @@ func (r *taintResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = r.client.scopeToOrg(ctx, &state.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		// Nothing to do
@@ -295,6 +318,6 @@ func (r *taintResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *taintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Support: terraform import autoglue_taint.example <taint_id>
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Support: terraform import autoglue_taint.example <taint_id> or <org_id>/<taint_id>
+	importStatePassthroughIDWithOrg(ctx, path.Root("id"), req, resp)
 }
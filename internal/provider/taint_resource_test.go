@@ -0,0 +1,31 @@
+package provider
+
+import "testing"
+
+func TestTaintResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	taint := h.resource("autoglue_taint")
+	taint.Apply(map[string]any{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"})
+	id := taint.Attr("id")
+
+	taint.Apply(map[string]any{"key": "dedicated", "value": "gpu", "effect": "NoExecute"})
+	if taint.Attr("id") != id || api.taints[id].Effect != "NoExecute" {
+		t.Errorf("taint not updated in place: %+v", api.taints[id])
+	}
+
+	taint.ImportVerify(id)
+
+	list := h.ReadDataSource("autoglue_taints", map[string]any{"key": "dedicated"})
+	if got := len(objectElems(t, list["taints"])); got != 1 {
+		t.Errorf("taints data source returned %d, want 1", got)
+	}
+
+	taint.ExpectReplace(map[string]any{"key": "other", "value": "gpu", "effect": "NoExecute"})
+
+	taint.Destroy()
+	if len(api.taints) != 0 {
+		t.Errorf("expected taint to be deleted")
+	}
+}
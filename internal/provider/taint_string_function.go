@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &taintStringFunction{}
+
+type taintStringFunction struct{}
+
+func NewTaintStringFunction() function.Function {
+	return &taintStringFunction{}
+}
+
+func (f *taintStringFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "taint_string"
+}
+
+func (f *taintStringFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders a taint in Kubernetes syntax.",
+		Description: "Renders a node taint the way `kubectl taint` and kubelet's `--register-with-taints` expect it: " +
+			"`key=value:Effect`, or `key:Effect` when `value` is null or empty.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "Taint key.",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:           "value",
+				Description:    "Taint value. May be null.",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:        "effect",
+				Description: "Taint effect: `NoSchedule`, `PreferNoSchedule` or `NoExecute`.",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf("NoSchedule", "PreferNoSchedule", "NoExecute"),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *taintStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key, effect string
+	var value types.String
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &key, &value, &effect))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, taintString(key, value.ValueString(), effect)))
+}
+
+// taintString renders a taint as key=value:Effect, or key:Effect without a value.
+func taintString(key, value, effect string) string {
+	if value == "" {
+		return key + ":" + effect
+	}
+	return key + "=" + value + ":" + effect
+}
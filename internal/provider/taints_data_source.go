@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,6 +25,8 @@ type taintsDataSourceModel struct {
 	Value  types.String            `tfsdk:"value"`
 	Q      types.String            `tfsdk:"q"`
 	Taints []taintsDataSourceTaint `tfsdk:"taints"`
+
+	OrgID types.String `tfsdk:"org_id"`
 }
 
 type taintsDataSourceTaint struct {
@@ -63,7 +64,7 @@ func (d *taintsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			},
 			"taints": dsschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "All taints returned by the API.",
+				Description: "Matching taints.",
 				NestedObject: dsschema.NestedAttributeObject{
 					Attributes: map[string]dsschema.Attribute{
 						"id": dsschema.StringAttribute{
@@ -93,6 +94,7 @@ func (d *taintsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -127,35 +129,26 @@ func (d *taintsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Build query params
-	u := "/taints"
-	q := url.Values{}
-
-	if !config.Key.IsNull() && !config.Key.IsUnknown() && config.Key.ValueString() != "" {
-		q.Set("key", config.Key.ValueString())
-	}
-
-	if !config.Value.IsNull() && !config.Value.IsUnknown() && config.Value.ValueString() != "" {
-		q.Set("value", config.Value.ValueString())
-	}
-
-	if !config.Q.IsNull() && !config.Q.IsUnknown() && config.Q.ValueString() != "" {
-		q.Set("q", config.Q.ValueString())
+	ctx = d.client.scopeToOrg(ctx, &config.OrgID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if encoded := q.Encode(); encoded != "" {
-		u = u + "?" + encoded
-	}
+	// Build query params
+	q := url.Values{}
+	setQueryFilter(q, "key", config.Key)
+	setQueryFilter(q, "value", config.Value)
+	setQueryFilter(q, "q", config.Q)
 
 	tflog.Info(ctx, "Listing Autoglue taints", map[string]any{
-		"path":  u,
+		"query": q.Encode(),
 		"key":   config.Key.ValueString(),
 		"value": config.Value.ValueString(),
 		"q":     config.Q.ValueString(),
 	})
 
-	var apiResp []taint
-	if err := d.client.doJSON(ctx, http.MethodGet, u, "", nil, &apiResp); err != nil {
+	apiResp, err := listAll[taint](ctx, d.client, "/taints", q)
+	if err != nil {
 		resp.Diagnostics.AddError("Error listing taints", err.Error())
 		return
 	}
@@ -167,6 +160,14 @@ func (d *taintsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	for _, t := range apiResp {
+		value := ""
+		if t.Value != nil {
+			value = *t.Value
+		}
+		if !matchesExact(config.Key, t.Key) || !matchesExact(config.Value, value) {
+			continue
+		}
+
 		item := taintsDataSourceTaint{
 			ID:        types.StringValue(t.ID),
 			Key:       types.StringValue(t.Key),
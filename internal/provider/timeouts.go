@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeoutsModel is the `timeouts` block shared by resources that wait on
+// asynchronous server-side work. Values are Go duration strings ("30m").
+type timeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// createUpdateTimeoutsModel is the `timeouts` block of resources whose
+// deletes return immediately, so only create and update can be waited on.
+type createUpdateTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+}
+
+func timeoutsBlock() resourceschema.SingleNestedBlock {
+	return timeoutsBlockFor("create", "update", "delete")
+}
+
+func createUpdateTimeoutsBlock() resourceschema.SingleNestedBlock {
+	return timeoutsBlockFor("create", "update")
+}
+
+func timeoutsBlockFor(ops ...string) resourceschema.SingleNestedBlock {
+	attrs := make(map[string]resourceschema.Attribute, len(ops))
+	for _, op := range ops {
+		attrs[op] = resourceschema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("How long to wait for %s to finish, as a duration string (e.g. `30m`, `1h`).", op),
+			Validators: []validator.String{
+				durationValidator{},
+			},
+		}
+	}
+	return resourceschema.SingleNestedBlock{
+		Description: "Operation timeouts.",
+		Attributes:  attrs,
+	}
+}
+
+func (t *timeoutsModel) CreateTimeout(def time.Duration) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return def, nil
+	}
+	return parseTimeout(t.Create, "create", def)
+}
+
+func (t *timeoutsModel) UpdateTimeout(def time.Duration) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return def, nil
+	}
+	return parseTimeout(t.Update, "update", def)
+}
+
+func (t *timeoutsModel) DeleteTimeout(def time.Duration) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return def, nil
+	}
+	return parseTimeout(t.Delete, "delete", def)
+}
+
+func (t *createUpdateTimeoutsModel) CreateTimeout(def time.Duration) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return def, nil
+	}
+	return parseTimeout(t.Create, "create", def)
+}
+
+func (t *createUpdateTimeoutsModel) UpdateTimeout(def time.Duration) (time.Duration, diag.Diagnostics) {
+	if t == nil {
+		return def, nil
+	}
+	return parseTimeout(t.Update, "update", def)
+}
+
+func parseTimeout(v types.String, name string, def time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return def, diags
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("timeouts").AtName(name),
+			"Invalid timeout",
+			fmt.Sprintf("Could not parse %q as a duration: %s", v.ValueString(), err),
+		)
+		return def, diags
+	}
+	return d, diags
+}
+
+// durationValidator checks that a string parses with time.ParseDuration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a duration string such as `30s`, `10m` or `1h`"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("Could not parse %q as a duration: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
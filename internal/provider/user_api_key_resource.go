@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &userAPIKeyResource{}
+	_ resource.ResourceWithConfigure   = &userAPIKeyResource{}
+	_ resource.ResourceWithImportState = &userAPIKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &userAPIKeyResource{}
+)
+
+type userAPIKeyResource struct {
+	client *autoglueClient
+}
+
+type userAPIKeyResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ExpiresInHours    types.Int64  `tfsdk:"expires_in_hours"`
+	RotateBeforeHours types.Int64  `tfsdk:"rotate_before_hours"`
+	Scope             types.String `tfsdk:"scope"`
+	Plain             types.String `tfsdk:"plain"`
+	Revoked           types.Bool   `tfsdk:"revoked"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	LastUsedAt        types.String `tfsdk:"last_used_at"`
+}
+
+func NewUserAPIKeyResource() resource.Resource {
+	return &userAPIKeyResource{}
+}
+
+func (r *userAPIKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_key"
+}
+
+func (r *userAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceschema.Schema{
+		Description: "Manages an API key for the calling user (X-API-KEY). " +
+			"The plaintext key is only returned when the key is created and is stored in Terraform state. " +
+			"The resource is planned for replacement once the key is revoked server-side, has expired, " +
+			"or is within `rotate_before_hours` of `expires_at`, so scheduled applies rotate keys automatically.",
+		Attributes: map[string]resourceschema.Attribute{
+			"id": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "API key ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": resourceschema.StringAttribute{
+				Optional:    true,
+				Description: "Human-readable key name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in_hours": resourceschema.Int64Attribute{
+				Optional:    true,
+				Description: "Key lifetime in hours, counted from creation. If omitted the key does not expire.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_before_hours": resourceschema.Int64Attribute{
+				Optional: true,
+				Description: "Plan a replacement key once the current key is within this many hours of `expires_at`. " +
+					"If omitted, the key is only replaced after it has expired or been revoked.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"scope": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Key scope (always `user`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plain": resourceschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Plaintext API key. Only available on the resource that created it; null after import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revoked": resourceschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the key has been revoked server-side.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Creation timestamp (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Expiry timestamp (RFC3339), if the key expires.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": resourceschema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp the key was last used (RFC3339), if ever.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userAPIKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan forces replacement of keys that are revoked, expired, or inside
+// the rotation window. Marking expires_at unknown gives Terraform a changed
+// attribute to attach the replacement to.
+func (r *userAPIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan userAPIKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reason := userAPIKeyRotationReason(state, plan.RotateBeforeHours, time.Now())
+	if reason == "" {
+		return
+	}
+
+	tflog.Info(ctx, "Planning Autoglue user API key rotation", map[string]any{
+		"id":     state.ID.ValueString(),
+		"reason": reason,
+	})
+
+	plan.ID = types.StringUnknown()
+	plan.Scope = types.StringUnknown()
+	plan.Plain = types.StringUnknown()
+	plan.Revoked = types.BoolUnknown()
+	plan.CreatedAt = types.StringUnknown()
+	plan.ExpiresAt = types.StringUnknown()
+	plan.LastUsedAt = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
+// userAPIKeyRotationReason returns why the key in state should be replaced, or "" if it is still good.
+func userAPIKeyRotationReason(state userAPIKeyResourceModel, rotateBeforeHours types.Int64, now time.Time) string {
+	if state.Revoked.ValueBool() {
+		return "key was revoked"
+	}
+	if state.ExpiresAt.IsNull() || state.ExpiresAt.IsUnknown() || state.ExpiresAt.ValueString() == "" {
+		return ""
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+	if err != nil {
+		return ""
+	}
+
+	window := time.Duration(rotateBeforeHours.ValueInt64()) * time.Hour
+	switch {
+	case !now.Before(expiresAt):
+		return "key has expired"
+	case window > 0 && !now.Before(expiresAt.Add(-window)):
+		return fmt.Sprintf("key expires at %s, within rotate_before_hours (%d)", expiresAt.Format(time.RFC3339), rotateBeforeHours.ValueInt64())
+	}
+	return ""
+}
+
+func (r *userAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var plan userAPIKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := createUserAPIKeyPayload{
+		Name:           plan.Name.ValueString(),
+		ExpiresInHours: plan.ExpiresInHours.ValueInt64Pointer(),
+	}
+
+	tflog.Info(ctx, "Creating Autoglue user API key", map[string]any{
+		"name":             payload.Name,
+		"expires_in_hours": plan.ExpiresInHours.ValueInt64(),
+	})
+
+	var apiResp userAPIKey
+	if err := r.client.doJSON(ctx, http.MethodPost, "/me/api-keys", "", payload, &apiResp); err != nil {
+		resp.Diagnostics.AddError("Error creating user API key", err.Error())
+		return
+	}
+
+	syncUserAPIKeyFromAPI(&plan, &apiResp)
+	plan.Plain = types.StringPointerValue(apiResp.Plain)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state userAPIKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var keys []userAPIKey
+	if err := r.client.doJSON(ctx, http.MethodGet, "/me/api-keys", "", nil, &keys); err != nil {
+		resp.Diagnostics.AddError("Error reading user API key", fmt.Sprintf("Error reading user API key %s: %s", id, err.Error()))
+		return
+	}
+
+	var found *userAPIKey
+	for i := range keys {
+		if keys[i].ID == id {
+			found = &keys[i]
+			break
+		}
+	}
+	if found == nil {
+		// Deleted keys disappear from the listing entirely.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The plaintext key is never listed; keep what was captured at create time.
+	syncUserAPIKeyFromAPI(&state, found)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only rotate_before_hours can change in place, and it is local to Terraform.
+	var plan userAPIKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var state userAPIKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		return
+	}
+
+	path := fmt.Sprintf("/me/api-keys/%s", id)
+	tflog.Info(ctx, "Deleting Autoglue user API key", map[string]any{"id": id})
+
+	if err := r.client.doJSON(ctx, http.MethodDelete, path, "", nil, nil); err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting user API key", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *userAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// terraform import autoglue_user_api_key.example <key_id>
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func syncUserAPIKeyFromAPI(state *userAPIKeyResourceModel, api *userAPIKey) {
+	state.ID = types.StringValue(api.ID)
+	if api.Name != nil && *api.Name != "" {
+		state.Name = types.StringValue(*api.Name)
+	} else {
+		state.Name = types.StringNull()
+	}
+	state.Scope = types.StringValue(api.Scope)
+	state.Revoked = types.BoolValue(api.Revoked)
+	state.CreatedAt = types.StringValue(api.CreatedAt)
+	state.ExpiresAt = types.StringPointerValue(api.ExpiresAt)
+	state.LastUsedAt = types.StringPointerValue(api.LastUsedAt)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserAPIKeyResource(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cfg := map[string]any{"name": "ci", "expires_in_hours": 48, "rotate_before_hours": 1}
+	key := h.resource("autoglue_user_api_key")
+	key.Apply(cfg)
+	id := key.Attr("id")
+	stored := api.userAPIKeys[id]
+	if stored == nil {
+		t.Fatalf("user API key not created")
+	}
+	if key.Attr("plain") != "ak_plain_"+id || key.Attr("scope") != "user" {
+		t.Errorf("plain=%q scope=%q", key.Attr("plain"), key.Attr("scope"))
+	}
+	if key.Attr("expires_at") != *stored.ExpiresAt {
+		t.Errorf("expires_at = %q, want %q", key.Attr("expires_at"), *stored.ExpiresAt)
+	}
+
+	// rotate_before_hours is local to Terraform and updates in place,
+	// keeping the computed attributes known.
+	cfg["rotate_before_hours"] = 2
+	key.Apply(cfg)
+	if key.Attr("id") != id || key.Attr("revoked") != "false" {
+		t.Errorf("in-place update changed id to %q, revoked=%q", key.Attr("id"), key.Attr("revoked"))
+	}
+
+	key.ExpectReplace(map[string]any{"name": "deploy", "expires_in_hours": 48, "rotate_before_hours": 2})
+
+	// The plaintext key is only returned on create.
+	key.ImportVerify(id, "plain", "expires_in_hours", "rotate_before_hours")
+}
+
+func TestUserAPIKeyResource_Rotation(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cfg := map[string]any{"name": "ci", "expires_in_hours": 48, "rotate_before_hours": 1}
+	key := h.resource("autoglue_user_api_key")
+	key.Apply(cfg)
+	id := key.Attr("id")
+
+	// A key inside rotate_before_hours of expires_at is replaced.
+	soon := time.Now().UTC().Add(30 * time.Minute).Format(time.RFC3339)
+	api.userAPIKeys[id].ExpiresAt = &soon
+	key.Refresh()
+	key.ExpectReplace(cfg)
+	key.Apply(cfg)
+	rotated := key.Attr("id")
+	if rotated == id {
+		t.Fatalf("expected a new key once inside rotate_before_hours")
+	}
+	if _, ok := api.userAPIKeys[id]; ok {
+		t.Errorf("rotated-out key %s was not deleted", id)
+	}
+
+	// An expired key is replaced even without a rotation window.
+	delete(cfg, "rotate_before_hours")
+	key.Apply(cfg)
+	past := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+	api.userAPIKeys[rotated].ExpiresAt = &past
+	key.Refresh()
+	key.ExpectReplace(cfg)
+	key.Apply(cfg)
+	if key.Attr("id") == rotated {
+		t.Errorf("expected expired key to be replaced")
+	}
+}
+
+func TestUserAPIKeyResource_Revoked(t *testing.T) {
+	api := newFakeAPI(t)
+	h := newTestHarness(t, api)
+
+	cfg := map[string]any{"name": "ci"}
+	key := h.resource("autoglue_user_api_key")
+	key.Apply(cfg)
+	id := key.Attr("id")
+
+	api.userAPIKeys[id].Revoked = true
+	key.Refresh()
+	if key.Attr("revoked") != "true" {
+		t.Fatalf("revoked = %q after refresh, want true", key.Attr("revoked"))
+	}
+	key.ExpectReplace(cfg)
+	key.Apply(cfg)
+	if key.Attr("id") == id || key.Attr("revoked") != "false" {
+		t.Errorf("revoked key not replaced: id=%q revoked=%q", key.Attr("id"), key.Attr("revoked"))
+	}
+
+	// A key deleted out-of-band drops out of state.
+	delete(api.userAPIKeys, key.Attr("id"))
+	if !key.Gone() {
+		t.Errorf("deleted user API key still in state")
+	}
+}
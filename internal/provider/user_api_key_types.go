@@ -0,0 +1,20 @@
+package provider
+
+// createUserAPIKeyPayload matches dto.CreateUserKeyRequest.
+type createUserAPIKeyPayload struct {
+	Name           string `json:"name,omitempty"`
+	ExpiresInHours *int64 `json:"expires_in_hours,omitempty"`
+}
+
+// userAPIKey represents dto.UserAPIKeyOut. Plain is only populated in the
+// response to POST /me/api-keys.
+type userAPIKey struct {
+	ID         string  `json:"id"`
+	Name       *string `json:"name"`
+	Scope      string  `json:"scope"`
+	Plain      *string `json:"plain"`
+	Revoked    bool    `json:"revoked"`
+	CreatedAt  string  `json:"created_at"`
+	ExpiresAt  *string `json:"expires_at"`
+	LastUsedAt *string `json:"last_used_at"`
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &userAPIKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &userAPIKeysDataSource{}
+)
+
+type userAPIKeysDataSource struct {
+	client *autoglueClient
+}
+
+type userAPIKeysDataSourceModel struct {
+	APIKeys []userAPIKeyDataModel `tfsdk:"api_keys"`
+}
+
+type userAPIKeyDataModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Scope      types.String `tfsdk:"scope"`
+	Revoked    types.Bool   `tfsdk:"revoked"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	LastUsedAt types.String `tfsdk:"last_used_at"`
+}
+
+func NewUserAPIKeysDataSource() datasource.DataSource {
+	return &userAPIKeysDataSource{}
+}
+
+func (d *userAPIKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_keys"
+}
+
+func (d *userAPIKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Lists the API keys of the calling user. Plaintext keys are never returned.",
+		Attributes: map[string]dsschema.Attribute{
+			"api_keys": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "User API keys.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "API key ID.",
+						},
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Key name, if any.",
+						},
+						"scope": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Key scope.",
+						},
+						"revoked": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the key has been revoked.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Creation timestamp.",
+						},
+						"expires_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Expiry timestamp, if any.",
+						},
+						"last_used_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Last use timestamp, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *userAPIKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*autoglueClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *autoglueClient, got %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *userAPIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client not configured", "The provider client was not configured.")
+		return
+	}
+
+	var config userAPIKeysDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Listing Autoglue user API keys")
+
+	apiResp, err := listAll[userAPIKey](ctx, d.client, "/me/api-keys", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing user API keys", err.Error())
+		return
+	}
+
+	config.APIKeys = make([]userAPIKeyDataModel, 0, len(apiResp))
+	for _, k := range apiResp {
+		config.APIKeys = append(config.APIKeys, userAPIKeyDataModel{
+			ID:         types.StringValue(k.ID),
+			Name:       types.StringPointerValue(k.Name),
+			Scope:      types.StringValue(k.Scope),
+			Revoked:    types.BoolValue(k.Revoked),
+			CreatedAt:  types.StringValue(k.CreatedAt),
+			ExpiresAt:  types.StringPointerValue(k.ExpiresAt),
+			LastUsedAt: types.StringPointerValue(k.LastUsedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Secrets the API never returns are modelled as write-only attributes
+// (Terraform 1.11+): they are read from config when sending them and never
+// stored in plan or state. Because Terraform can't diff a write-only value,
+// each one has an optional companion *_version attribute; changing it is what
+// makes the provider send the value again.
+//
+// Resources that used to keep such a secret in state bump their schema
+// version and drop it from old state with nullAttributesUpgrader.
+
+// nullAttributesUpgrader returns a state upgrader that keeps the prior state
+// as is, except that attrs are set to null (and added if they didn't exist).
+func nullAttributesUpgrader(attrs ...string) resource.StateUpgrader {
+	values := make(map[string]any, len(attrs))
+	for _, a := range attrs {
+		values[a] = nil
+	}
+	return setAttributesUpgrader(values)
+}